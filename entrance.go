@@ -2,8 +2,12 @@ package bear
 
 import (
 	"github.com/chaseSpace/bear/constraints"
+	"github.com/chaseSpace/bear/scache"
+	"github.com/chaseSpace/bear/sheap"
+	"github.com/chaseSpace/bear/sprob"
 	"github.com/chaseSpace/bear/sset"
 	"github.com/chaseSpace/bear/sslice"
+	"time"
 )
 
 // NewSlice creates a new instance of Slice.
@@ -25,3 +29,98 @@ func NewOrderedSlice[T constraints.Ordered](data ...T) *sslice.OrderedSlice[T] {
 func NewSet[T comparable](data ...T) *sset.Set[T] {
 	return sset.New(data...)
 }
+
+// NewOrderedSet creates a new instance of OrderedSet, which keeps the insertion order of its items.
+func NewOrderedSet[T comparable](data ...T) *sset.OrderedSet[T] {
+	return sset.NewOrdered(data...)
+}
+
+// NewSortedSet creates a new instance of SortedSet, which keeps its items in ascending order.
+func NewSortedSet[T constraints.Ordered](data ...T) *sset.SortedSet[T] {
+	return sset.NewSorted(data...)
+}
+
+// NewMultiSet creates a new instance of MultiSet, which counts each occurrence of data.
+func NewMultiSet[T comparable](data ...T) *sset.MultiSet[T] {
+	return sset.NewMulti(data...)
+}
+
+// NewBitSet creates a new instance of BitSet, which suits dense non-negative integers.
+func NewBitSet(data ...uint) *sset.BitSet {
+	return sset.NewBitSet(data...)
+}
+
+// NewBitmap creates a new instance of Bitmap, a compressed set of uint32 values.
+func NewBitmap(data ...uint32) *sset.Bitmap {
+	return sset.NewBitmap(data...)
+}
+
+// NewBloomFilter creates a new instance of BloomFilter sized for the expected number of items and false-positive rate.
+func NewBloomFilter[T comparable](expected int, fpRate float64) *sprob.BloomFilter[T] {
+	return sprob.NewBloom[T](expected, fpRate)
+}
+
+// NewCountingBloomFilter creates a new instance of CountingBloomFilter, a BloomFilter that supports deletion.
+func NewCountingBloomFilter[T comparable](expected int, fpRate float64) *sprob.CountingBloomFilter[T] {
+	return sprob.NewCountingBloom[T](expected, fpRate)
+}
+
+// NewHyperLogLog creates a new instance of HyperLogLog, which estimates the number of distinct items.
+func NewHyperLogLog[T comparable](precision int) *sprob.HyperLogLog[T] {
+	return sprob.NewHyperLogLog[T](precision)
+}
+
+// NewMinHash creates a new instance of MinHash, which estimates the Jaccard similarity of sets.
+func NewMinHash[T comparable](size int) *sprob.MinHash[T] {
+	return sprob.NewMinHash[T](size)
+}
+
+// NewConcurrentSlice creates a new instance of ConcurrentSlice.
+func NewConcurrentSlice[T comparable](data ...T) *sslice.ConcurrentSlice[T] {
+	return sslice.NewConcurrent(data...)
+}
+
+// NewConcurrentSet creates a new instance of ConcurrentSet.
+func NewConcurrentSet[T comparable](data ...T) *sset.ConcurrentSet[T] {
+	return sset.NewConcurrent(data...)
+}
+
+// NewShardedSet creates a new instance of ShardedSet with the given number of shards.
+func NewShardedSet[T comparable](shardCount int, data ...T) *sset.ShardedSet[T] {
+	return sset.NewSharded(shardCount, data...)
+}
+
+// NewSortedSlice creates a new instance of SortedSlice.
+func NewSortedSlice[T constraints.Ordered](data ...T) *sslice.SortedSlice[T] {
+	return sslice.NewSortedSlice(data...)
+}
+
+// NewHeap creates a new instance of Heap ordered by the less function.
+func NewHeap[T any](less func(a, b T) bool, data ...T) *sheap.Heap[T] {
+	return sheap.New(less, data...)
+}
+
+// NewMinHeap creates a new instance of min-Heap.
+func NewMinHeap[T constraints.Ordered](data ...T) *sheap.Heap[T] {
+	return sheap.NewMin(data...)
+}
+
+// NewMaxHeap creates a new instance of max-Heap.
+func NewMaxHeap[T constraints.Ordered](data ...T) *sheap.Heap[T] {
+	return sheap.NewMax(data...)
+}
+
+// NewLRU creates a new instance of LRU cache, which holds at most capacity entries.
+func NewLRU[K comparable, V any](capacity int) *scache.LRU[K, V] {
+	return scache.NewLRU[K, V](capacity)
+}
+
+// NewLFU creates a new instance of LFU cache, which holds at most capacity entries.
+func NewLFU[K comparable, V any](capacity int) *scache.LFU[K, V] {
+	return scache.NewLFU[K, V](capacity)
+}
+
+// NewTTLCache creates a new instance of TTLCache, whose entries expire ttl after they are set.
+func NewTTLCache[K comparable, V any](capacity int, ttl time.Duration) *scache.TTLCache[K, V] {
+	return scache.NewTTL[K, V](capacity, ttl)
+}
@@ -0,0 +1,114 @@
+// Package combin generates the index tuples of combinatorial sequences lazily, in lexicographic order.
+// Each generator calls yield with a slice of indices, which is reused between calls, and stops when yield
+// returns false.
+package combin
+
+// Combinations yields the k-combinations of the indices [0, n), e.g. [0 1] [0 2] [1 2] for n = 3 and k = 2.
+func Combinations(n, k int, yield func([]int) bool) {
+	if k < 0 || k > n {
+		return
+	}
+	var idx = make([]int, k)
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		if !yield(idx) {
+			return
+		}
+		// find the rightmost index that can be increased
+		i := k - 1
+		for i >= 0 && idx[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[j-1] + 1
+		}
+	}
+}
+
+// CombinationsWithReplacement yields the k-combinations of the indices [0, n) with repetition,
+// e.g. [0 0] [0 1] [1 1] for n = 2 and k = 2.
+func CombinationsWithReplacement(n, k int, yield func([]int) bool) {
+	if k < 0 || n == 0 && k > 0 {
+		return
+	}
+	var idx = make([]int, k)
+	for {
+		if !yield(idx) {
+			return
+		}
+		i := k - 1
+		for i >= 0 && idx[i] == n-1 {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[i]
+		}
+	}
+}
+
+// Permutations yields the permutations of the indices [0, n), e.g. [0 1] [1 0] for n = 2.
+func Permutations(n int, yield func([]int) bool) {
+	if n < 0 {
+		return
+	}
+	var idx = make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		if !yield(idx) {
+			return
+		}
+		// the next permutation in lexicographic order
+		i := n - 2
+		for i >= 0 && idx[i] > idx[i+1] {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		j := n - 1
+		for idx[j] < idx[i] {
+			j--
+		}
+		idx[i], idx[j] = idx[j], idx[i]
+		for l, r := i+1, n-1; l < r; l, r = l+1, r-1 {
+			idx[l], idx[r] = idx[r], idx[l]
+		}
+	}
+}
+
+// Product yields the tuples of the Cartesian product of the indices [0, sizes[0]), [0, sizes[1]), ...,
+// e.g. [0 0] [0 1] [1 0] [1 1] for sizes [2 2]. It yields one empty tuple if sizes is empty.
+func Product(sizes []int, yield func([]int) bool) {
+	for _, size := range sizes {
+		if size <= 0 {
+			return
+		}
+	}
+	var idx = make([]int, len(sizes))
+	for {
+		if !yield(idx) {
+			return
+		}
+		i := len(sizes) - 1
+		for i >= 0 && idx[i] == sizes[i]-1 {
+			idx[i] = 0
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		idx[i]++
+	}
+}
@@ -0,0 +1,55 @@
+package combin
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func collect(gen func(yield func([]int) bool)) [][]int {
+	var result [][]int
+	gen(func(idx []int) bool {
+		result = append(result, append([]int{}, idx...))
+		return true
+	})
+	return result
+}
+
+func TestCombinations(t *testing.T) {
+	assert.Equal(t, [][]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}},
+		collect(func(y func([]int) bool) { Combinations(4, 2, y) }))
+	assert.Equal(t, [][]int{{}}, collect(func(y func([]int) bool) { Combinations(3, 0, y) }))
+	assert.Equal(t, [][]int{{0, 1, 2}}, collect(func(y func([]int) bool) { Combinations(3, 3, y) }))
+	assert.Nil(t, collect(func(y func([]int) bool) { Combinations(2, 3, y) }))
+	assert.Nil(t, collect(func(y func([]int) bool) { Combinations(2, -1, y) }))
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	assert.Equal(t, [][]int{{0, 0}, {0, 1}, {0, 2}, {1, 1}, {1, 2}, {2, 2}},
+		collect(func(y func([]int) bool) { CombinationsWithReplacement(3, 2, y) }))
+	assert.Equal(t, [][]int{{0, 0, 0}}, collect(func(y func([]int) bool) { CombinationsWithReplacement(1, 3, y) }))
+	assert.Equal(t, [][]int{{}}, collect(func(y func([]int) bool) { CombinationsWithReplacement(0, 0, y) }))
+	assert.Nil(t, collect(func(y func([]int) bool) { CombinationsWithReplacement(0, 1, y) }))
+}
+
+func TestPermutations(t *testing.T) {
+	assert.Equal(t, [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}},
+		collect(func(y func([]int) bool) { Permutations(3, y) }))
+	assert.Equal(t, [][]int{{}}, collect(func(y func([]int) bool) { Permutations(0, y) }))
+	assert.Len(t, collect(func(y func([]int) bool) { Permutations(5, y) }), 120)
+}
+
+func TestProduct(t *testing.T) {
+	assert.Equal(t, [][]int{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}},
+		collect(func(y func([]int) bool) { Product([]int{2, 3}, y) }))
+	assert.Equal(t, [][]int{{}}, collect(func(y func([]int) bool) { Product(nil, y) }))
+	assert.Nil(t, collect(func(y func([]int) bool) { Product([]int{2, 0}, y) }))
+}
+
+func TestGenerators_StopEarly(t *testing.T) {
+	var calls int
+	Permutations(10, func([]int) bool {
+		calls++
+		return calls < 3
+	})
+	assert.Equal(t, 3, calls)
+}
@@ -0,0 +1,124 @@
+// Package fakesql is an in-process database/sql driver for tests. It stores one value per key:
+//
+//	db.Exec("SET", key, value)
+//	db.QueryRow("GET", key).Scan(&value)
+//
+// Like most real drivers, it returns strings as []byte.
+package fakesql
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+var seq int64
+
+// Open returns a new database, which does not share the stored values with other databases.
+func Open() (*sql.DB, error) {
+	var name = fmt.Sprintf("bear-fakesql-%d", atomic.AddInt64(&seq, 1))
+	sql.Register(name, &fakeDriver{values: make(map[string]driver.Value)})
+	return sql.Open(name, "")
+}
+
+type fakeDriver struct {
+	mu     sync.Mutex
+	values map[string]driver.Value
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	return &conn{driver: d}, nil
+}
+
+type conn struct {
+	driver *fakeDriver
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	if query != "SET" && query != "GET" {
+		return nil, fmt.Errorf("unknown query: %s", query)
+	}
+	return &stmt{conn: c, query: query}, nil
+}
+
+func (c *conn) Close() error {
+	return nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type stmt struct {
+	conn  *conn
+	query string
+}
+
+func (s *stmt) Close() error {
+	return nil
+}
+
+func (s *stmt) NumInput() int {
+	if s.query == "SET" {
+		return 2
+	}
+	return 1
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	if s.query != "SET" {
+		return nil, errors.New("use Query for GET")
+	}
+	key, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("key must be a string, got %T", args[0])
+	}
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+	s.conn.driver.values[key] = args[1]
+	return driver.RowsAffected(1), nil
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.query != "GET" {
+		return nil, errors.New("use Exec for SET")
+	}
+	key, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("key must be a string, got %T", args[0])
+	}
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+	value, ok := s.conn.driver.values[key]
+	if !ok {
+		return &rows{}, nil
+	}
+	if str, ok := value.(string); ok {
+		value = []byte(str)
+	}
+	return &rows{values: []driver.Value{value}}, nil
+}
+
+type rows struct {
+	values []driver.Value
+}
+
+func (r *rows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
@@ -0,0 +1,132 @@
+// Package hashing provides fast, deterministic 64-bit hash functions for comparable values.
+// The hashes are stable across processes, so they can be stored, e.g. in a serialized Bloom filter,
+// except those of pointers and channels, which are hashed by their address.
+package hashing
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// Default returns a hasher for T, which gives equal items (by ==) the same hash. It hashes strings with FNV-1a,
+// and numbers and bools by mixing their bits, reading the item in place so that hashing does not allocate.
+// Pointers and channels are hashed by their address, so their hashes are only stable within a process.
+// Structs, arrays and interfaces are hashed by walking their fields, elements or dynamic value with reflect.
+func Default[T comparable]() func(T) uint64 {
+	var zero T
+	var typ = reflect.TypeOf(zero)
+	if typ == nil { // T is an interface type
+		return byReflect[T]
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return func(item T) uint64 {
+			return String(*(*string)(unsafe.Pointer(&item)))
+		}
+	case reflect.Float32:
+		return func(item T) uint64 {
+			return Float(float64(*(*float32)(unsafe.Pointer(&item))))
+		}
+	case reflect.Float64:
+		return func(item T) uint64 {
+			return Float(*(*float64)(unsafe.Pointer(&item)))
+		}
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		switch typ.Size() {
+		case 1:
+			return func(item T) uint64 { return Mix64(uint64(*(*uint8)(unsafe.Pointer(&item)))) }
+		case 2:
+			return func(item T) uint64 { return Mix64(uint64(*(*uint16)(unsafe.Pointer(&item)))) }
+		case 4:
+			return func(item T) uint64 { return Mix64(uint64(*(*uint32)(unsafe.Pointer(&item)))) }
+		default:
+			return func(item T) uint64 { return Mix64(*(*uint64)(unsafe.Pointer(&item))) }
+		}
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		// == compares the address, not the value pointed to
+		return func(item T) uint64 { return Mix64(uint64(*(*uintptr)(unsafe.Pointer(&item)))) }
+	default:
+		return byReflect[T]
+	}
+}
+
+func byReflect[T comparable](item T) uint64 {
+	return hashValue(reflect.ValueOf(&item).Elem())
+}
+
+// hashValue hashes v consistently with ==.
+func hashValue(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.String:
+		return String(v.String())
+	case reflect.Bool:
+		if v.Bool() {
+			return Mix64(1)
+		}
+		return Mix64(0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Mix64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Mix64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return Float(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return Mix64(Float(real(c)) ^ Float(imag(c))*31)
+	case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+		return Mix64(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			return Mix64(0)
+		}
+		return hashValue(v.Elem())
+	case reflect.Struct:
+		var h uint64 = 0x9e3779b97f4a7c15
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Name == "_" { // == ignores blank fields
+				continue
+			}
+			h = Mix64(h ^ hashValue(v.Field(i)))
+		}
+		return h
+	case reflect.Array:
+		var h uint64 = 0x9e3779b97f4a7c15
+		for i := 0; i < v.Len(); i++ {
+			h = Mix64(h ^ hashValue(v.Index(i)))
+		}
+		return h
+	default: // the dynamic value of an interface is not comparable, so == panics on it anyway
+		return String(fmt.Sprintf("%#v", v))
+	}
+}
+
+// Float hashes f, giving +0 and -0 the same hash.
+func Float(f float64) uint64 {
+	if f == 0 { // +0 and -0 are equal, so they must have the same hash
+		f = 0
+	}
+	return Mix64(math.Float64bits(f))
+}
+
+// Mix64 is the finalizer of splitmix64, which spreads the bits of x over the whole word.
+func Mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// String hashes s with 64-bit FNV-1a.
+func String(s string) uint64 {
+	var h uint64 = 14695981039346656037
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return h
+}
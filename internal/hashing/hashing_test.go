@@ -0,0 +1,93 @@
+package hashing
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+func TestDefault_EqualFloatsHaveSameHash(t *testing.T) {
+	zero, negZero := 0.0, 0.0
+	negZero = -negZero
+	hasher := Default[float64]()
+	assert.Equal(t, hasher(zero), hasher(negZero))
+
+	type id int16
+	idHasher := Default[id]()
+	assert.Equal(t, idHasher(-1), idHasher(-1))
+	assert.NotEqual(t, idHasher(1), idHasher(2))
+}
+
+func TestDefault_Kinds(t *testing.T) {
+	assert.Equal(t, String("a"), Default[string]()("a"))
+	assert.Equal(t, Mix64(7), Default[uint64]()(7))
+	assert.Equal(t, Mix64(1), Default[bool]()(true))
+
+	type key struct {
+		tenant string
+		id     int
+	}
+	keyHasher := Default[key]()
+	assert.Equal(t, keyHasher(key{"a", 1}), keyHasher(key{"a", 1}))
+	assert.NotEqual(t, keyHasher(key{"a", 1}), keyHasher(key{"a", 2}))
+}
+
+func TestDefault_PointersAreHashedByAddress(t *testing.T) {
+	type user struct {
+		id int
+	}
+	u := &user{1}
+	hasher := Default[*user]()
+	before := hasher(u)
+	u.id = 2
+	assert.Equal(t, before, hasher(u))
+	assert.NotEqual(t, hasher(u), hasher(&user{2}))
+
+	ch := make(chan int)
+	assert.Equal(t, Default[chan int]()(ch), Default[chan int]()(ch))
+}
+
+func TestDefault_InterfacesUseTheDynamicType(t *testing.T) {
+	type user struct {
+		id int
+	}
+	u := &user{1}
+	// interface{} cannot be a type argument of Default before go1.20, so call the reflect hasher directly
+	hasher := func(v interface{}) uint64 { return hashValue(reflect.ValueOf(&v).Elem()) }
+	var a, b interface{} = u, u
+	before := hasher(a)
+	u.id = 2
+	assert.Equal(t, before, hasher(b))
+
+	zero, negZero := 0.0, 0.0
+	negZero = -negZero
+	assert.Equal(t, hasher(zero), hasher(negZero))
+	assert.Equal(t, hasher("a"), hasher("a"))
+	assert.Equal(t, hasher(nil), hasher(nil))
+	assert.Equal(t, hasher([2]interface{}{1, u}), hasher([2]interface{}{1, u}))
+}
+
+func TestDefault_StructsAreHashedByField(t *testing.T) {
+	type point struct {
+		x, y float64
+		_    int
+		p    *int
+	}
+	zero, negZero := 0.0, 0.0
+	negZero = -negZero
+	n := 1
+	hasher := Default[point]()
+	assert.Equal(t, hasher(point{x: zero, p: &n}), hasher(point{x: negZero, p: &n}))
+	assert.NotEqual(t, hasher(point{x: 1, y: 2}), hasher(point{x: 2, y: 1}))
+
+	arrayHasher := Default[[2]*int]()
+	assert.Equal(t, arrayHasher([2]*int{nil, &n}), arrayHasher([2]*int{nil, &n}))
+	assert.NotEqual(t, arrayHasher([2]*int{nil, &n}), arrayHasher([2]*int{&n, nil}))
+	assert.Equal(t, Default[complex128]()(complex(zero, 1)), Default[complex128]()(complex(negZero, 1)))
+}
+
+func TestString_IsStable(t *testing.T) {
+	// the hashes may be stored, so they must not change between versions
+	assert.Equal(t, uint64(0xcbf29ce484222325), String(""))
+	assert.Equal(t, uint64(0xaf63dc4c8601ec8c), String("a"))
+}
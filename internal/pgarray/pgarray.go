@@ -0,0 +1,211 @@
+// Package pgarray encodes and decodes one-dimensional Postgres array literals, like {1,2,3} or {"a","b"}.
+// The element type must be a string, integer, float or bool type, including named types of them.
+package pgarray
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Format returns the Postgres array literal of items. Strings are always quoted.
+func Format[T comparable](items []T) (string, error) {
+	var zero T
+	if err := checkType(reflect.TypeOf(zero)); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, item := range items {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		formatElem(&b, reflect.ValueOf(item))
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// Parse parses a one-dimensional Postgres array literal into items. It returns an error for NULL elements,
+// because they can not be represented by T.
+func Parse[T comparable](src string) ([]T, error) {
+	var zero T
+	var typ = reflect.TypeOf(zero)
+	if err := checkType(typ); err != nil {
+		return nil, err
+	}
+	elems, err := split(src)
+	if err != nil {
+		return nil, err
+	}
+	var items = make([]T, 0, len(elems))
+	for _, e := range elems {
+		if e == nil {
+			return nil, errors.New("array contains NULL element")
+		}
+		v := reflect.New(typ).Elem()
+		if err = parseElem(v, *e); err != nil {
+			return nil, err
+		}
+		items = append(items, v.Interface().(T))
+	}
+	return items, nil
+}
+
+func checkType(typ reflect.Type) error {
+	if typ == nil {
+		return errors.New("unsupported array element type: interface")
+	}
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return nil
+	default:
+		return fmt.Errorf("unsupported array element type: %s", typ)
+	}
+}
+
+func formatElem(b *strings.Builder, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		b.WriteByte('"')
+		for _, r := range v.String() {
+			if r == '"' || r == '\\' {
+				b.WriteByte('\\')
+			}
+			b.WriteRune(r)
+		}
+		b.WriteByte('"')
+	case reflect.Bool:
+		if v.Bool() {
+			b.WriteString("t")
+		} else {
+			b.WriteString("f")
+		}
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch {
+		case math.IsInf(f, 1):
+			b.WriteString("Infinity")
+		case math.IsInf(f, -1):
+			b.WriteString("-Infinity")
+		default: // NaN is formatted as NaN, which Postgres accepts too
+			b.WriteString(strconv.FormatFloat(f, 'g', -1, v.Type().Bits()))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b.WriteString(strconv.FormatUint(v.Uint(), 10))
+	default:
+		b.WriteString(strconv.FormatInt(v.Int(), 10))
+	}
+}
+
+func parseElem(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	default:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	}
+	return nil
+}
+
+// split splits an array literal into its elements, unquoting them. A nil element is NULL.
+func split(src string) ([]*string, error) {
+	src = strings.TrimSpace(src)
+	if len(src) < 2 || src[0] != '{' || src[len(src)-1] != '}' {
+		return nil, fmt.Errorf("invalid array literal: %q", src)
+	}
+	var body = src[1 : len(src)-1]
+	var elems []*string
+	if strings.TrimSpace(body) == "" {
+		return elems, nil
+	}
+	for i := 0; ; {
+		for i < len(body) && body[i] == ' ' {
+			i++
+		}
+		var elem strings.Builder
+		var quoted bool
+		if i < len(body) && body[i] == '"' {
+			quoted = true
+			i++
+			for ; i < len(body) && body[i] != '"'; i++ {
+				if body[i] == '\\' {
+					i++
+					if i == len(body) {
+						break
+					}
+				}
+				elem.WriteByte(body[i])
+			}
+			if i == len(body) {
+				return nil, fmt.Errorf("unterminated quoted element in array literal: %q", src)
+			}
+			i++ // skip the closing quote
+			for i < len(body) && body[i] == ' ' {
+				i++
+			}
+		} else {
+			for ; i < len(body) && body[i] != ','; i++ {
+				switch body[i] {
+				case '{', '}', '"':
+					return nil, fmt.Errorf("unsupported array literal, only one-dimensional arrays are supported: %q", src)
+				case '\\':
+					i++
+					if i == len(body) {
+						return nil, fmt.Errorf("invalid array literal: %q", src)
+					}
+				}
+				elem.WriteByte(body[i])
+			}
+		}
+
+		s := elem.String()
+		if !quoted {
+			s = strings.TrimRight(s, " ")
+			if s == "" {
+				return nil, fmt.Errorf("empty element in array literal: %q", src)
+			}
+		}
+		if !quoted && strings.EqualFold(s, "NULL") {
+			elems = append(elems, nil)
+		} else {
+			elems = append(elems, &s)
+		}
+
+		if i == len(body) {
+			return elems, nil
+		}
+		if body[i] != ',' {
+			return nil, fmt.Errorf("invalid array literal: %q", src)
+		}
+		i++
+	}
+}
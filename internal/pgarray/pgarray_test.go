@@ -0,0 +1,75 @@
+package pgarray
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	s, err := Format([]string{"a", `b"c`, `d\e`, "", "NULL", "x,y", " {z} "})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"a","b\"c","d\\e","","NULL","x,y"," {z} "}`, s)
+
+	s, err = Format([]int{1, -2})
+	assert.Nil(t, err)
+	assert.Equal(t, `{1,-2}`, s)
+
+	s, err = Format([]float64{1.5, math.Inf(1), math.Inf(-1), math.NaN()})
+	assert.Nil(t, err)
+	assert.Equal(t, `{1.5,Infinity,-Infinity,NaN}`, s)
+
+	s, err = Format([]bool{true, false})
+	assert.Nil(t, err)
+	assert.Equal(t, `{t,f}`, s)
+
+	s, err = Format([]uint8{})
+	assert.Nil(t, err)
+	assert.Equal(t, `{}`, s)
+
+	_, err = Format([]struct{}{{}})
+	assert.NotNil(t, err)
+}
+
+func TestParse(t *testing.T) {
+	strs, err := Parse[string](`{"a","b\"c","d\\e","","NULL","x,y"," {z} ",plain, spaced out ,esc\,aped}`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", `b"c`, `d\e`, "", "NULL", "x,y", " {z} ", "plain", "spaced out", "esc,aped"}, strs)
+
+	type id int32
+	ids, err := Parse[id](` { 1 , -2,3 } `)
+	assert.Nil(t, err)
+	assert.Equal(t, []id{1, -2, 3}, ids)
+
+	floats, err := Parse[float64](`{1.5,Infinity,-Infinity}`)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{1.5, math.Inf(1), math.Inf(-1)}, floats)
+
+	bools, err := Parse[bool](`{t,f,true,FALSE}`)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true, false, true, false}, bools)
+
+	empty, err := Parse[uint](`{}`)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint{}, empty)
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, src := range []string{``, `1,2`, `{1,2`, `{1,,2}`, `{1,}`, `{{1,2},{3,4}}`, `{"a}`, `{"a"b}`, `{NULL}`, `{x}`, `{300}`} {
+		_, err := Parse[uint8](src)
+		assert.NotNil(t, err, src)
+	}
+	_, err := Parse[string](`{NULL}`)
+	assert.NotNil(t, err)
+	_, err = Parse[struct{}](`{}`)
+	assert.NotNil(t, err)
+}
+
+func TestFormatParse_RoundTrip(t *testing.T) {
+	var items = []string{"", `"`, `\`, "a b", "{}", ",", "NULL", "日本"}
+	s, err := Format(items)
+	assert.Nil(t, err)
+	parsed, err := Parse[string](s)
+	assert.Nil(t, err)
+	assert.Equal(t, items, parsed)
+}
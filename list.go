@@ -0,0 +1,52 @@
+package bear
+
+import (
+	"github.com/chaseSpace/bear/slinkedlist"
+	"github.com/chaseSpace/bear/sslice"
+)
+
+// List is the common index-based interface of the list types, so that an algorithm can accept any of them.
+// It is implemented by SinglyLinkedList, DoublyLinkedList, their concurrent variants, and sslice.ListAdapter,
+// which adapts a Slice (see AsList).
+//
+// InsertBefore, InsertAfter and Update return an error if the index does not refer to an element,
+// while Remove does nothing in that case.
+type List[T comparable] interface {
+	// Append adds one or more values to the end of the list.
+	Append(val ...T)
+	// InsertBefore inserts val before the element at the specified index.
+	InsertBefore(index int, val T) error
+	// InsertAfter inserts val after the element at the specified index.
+	InsertAfter(index int, val T) error
+	// Remove removes the element at the specified index.
+	Remove(index int)
+	// IndexOf returns the index of the first occurrence of val, or -1 if it is not found.
+	IndexOf(val T) int
+	// Update updates the value of the element at the specified index.
+	Update(index int, newVal T) error
+	// Iterate calls f sequentially for each element in order, and stops when f returns false.
+	Iterate(f func(T) bool)
+	// Reverse reverses the list.
+	Reverse()
+	// ToSlice returns the elements as a standard Go slice.
+	ToSlice() []T
+	// Length returns the number of elements.
+	Length() int
+	// IsEmpty checks if the list is empty.
+	IsEmpty() bool
+	// CountOf counts occurrences of val.
+	CountOf(val T) int
+}
+
+var (
+	_ List[int] = (*slinkedlist.SinglyLinkedList[int])(nil)
+	_ List[int] = (*slinkedlist.DoublyLinkedList[int])(nil)
+	_ List[int] = (*slinkedlist.ConcurrentSinglyLinkedList[int])(nil)
+	_ List[int] = (*slinkedlist.ConcurrentDoublyLinkedList[int])(nil)
+	_ List[int] = (*sslice.ListAdapter[int])(nil)
+)
+
+// AsList returns s as a List. Changes made through the List are made to s.
+func AsList[T comparable](s *sslice.Slice[T]) List[T] {
+	return s.AsList()
+}
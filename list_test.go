@@ -0,0 +1,182 @@
+package bear
+
+import (
+	"github.com/chaseSpace/bear/slinkedlist"
+	"github.com/chaseSpace/bear/sslice"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// listImpls returns a constructor for each implementation of List, which all run the conformance suite.
+func listImpls() map[string]func() List[int] {
+	return map[string]func() List[int]{
+		"SinglyLinkedList": func() List[int] { return slinkedlist.NewSinglyLinkedList[int]() },
+		"DoublyLinkedList": func() List[int] { return slinkedlist.NewDoublyLinkedList[int]() },
+		"ConcurrentSinglyLinkedList": func() List[int] {
+			return slinkedlist.NewConcurrentSinglyLinkedList[int]()
+		},
+		"ConcurrentDoublyLinkedList": func() List[int] {
+			return slinkedlist.NewConcurrentDoublyLinkedList[int]()
+		},
+		"Slice": func() List[int] { return AsList(sslice.New[int]()) },
+	}
+}
+
+func newList(newFn func() List[int], vals ...int) List[int] {
+	list := newFn()
+	list.Append(vals...)
+	return list
+}
+
+// toSlice is ToSlice that treats a nil slice as empty, since implementations differ on that.
+func toSlice(list List[int]) []int {
+	if s := list.ToSlice(); s != nil {
+		return s
+	}
+	return []int{}
+}
+
+func TestList_Conformance(t *testing.T) {
+	for name, newFn := range listImpls() {
+		newFn := newFn
+		t.Run(name, func(t *testing.T) {
+			t.Run("Empty", func(t *testing.T) {
+				list := newFn()
+				assert.True(t, list.IsEmpty())
+				assert.Equal(t, 0, list.Length())
+				assert.Equal(t, []int{}, toSlice(list))
+				assert.Equal(t, -1, list.IndexOf(1))
+				assert.Equal(t, 0, list.CountOf(1))
+				assert.Error(t, list.InsertBefore(0, 1))
+				assert.Error(t, list.InsertAfter(0, 1))
+				assert.Error(t, list.Update(0, 1))
+				list.Remove(0)
+				list.Reverse()
+				assert.True(t, list.IsEmpty())
+			})
+
+			t.Run("Append", func(t *testing.T) {
+				list := newFn()
+				list.Append()
+				assert.True(t, list.IsEmpty())
+				list.Append(1)
+				list.Append(2, 3)
+				assert.False(t, list.IsEmpty())
+				assert.Equal(t, 3, list.Length())
+				assert.Equal(t, []int{1, 2, 3}, toSlice(list))
+			})
+
+			t.Run("InsertBefore", func(t *testing.T) {
+				list := newList(newFn, 1, 2, 3)
+				assert.Nil(t, list.InsertBefore(0, 0))
+				assert.Nil(t, list.InsertBefore(3, 25))
+				assert.Equal(t, []int{0, 1, 2, 25, 3}, toSlice(list))
+				assert.Error(t, list.InsertBefore(-1, 9))
+				assert.Error(t, list.InsertBefore(5, 9))
+				assert.Error(t, list.InsertBefore(6, 9))
+				assert.Equal(t, []int{0, 1, 2, 25, 3}, toSlice(list))
+			})
+
+			t.Run("InsertAfter", func(t *testing.T) {
+				list := newList(newFn, 1, 2, 3)
+				assert.Nil(t, list.InsertAfter(0, 15))
+				assert.Nil(t, list.InsertAfter(3, 4))
+				assert.Equal(t, []int{1, 15, 2, 3, 4}, toSlice(list))
+				assert.Error(t, list.InsertAfter(-1, 9))
+				assert.Error(t, list.InsertAfter(5, 9))
+				// the inserted tail is the tail for the next Append
+				list.Append(5)
+				assert.Equal(t, []int{1, 15, 2, 3, 4, 5}, toSlice(list))
+			})
+
+			t.Run("Remove", func(t *testing.T) {
+				list := newList(newFn, 1, 2, 3, 4)
+				list.Remove(-1)
+				list.Remove(4)
+				assert.Equal(t, []int{1, 2, 3, 4}, toSlice(list))
+				list.Remove(1)
+				assert.Equal(t, []int{1, 3, 4}, toSlice(list))
+				list.Remove(2)
+				assert.Equal(t, []int{1, 3}, toSlice(list))
+				list.Remove(0)
+				assert.Equal(t, []int{3}, toSlice(list))
+				list.Append(5)
+				assert.Equal(t, []int{3, 5}, toSlice(list))
+				list.Remove(0)
+				list.Remove(0)
+				assert.True(t, list.IsEmpty())
+				list.Append(6)
+				assert.Equal(t, []int{6}, toSlice(list))
+			})
+
+			t.Run("IndexOfCountOf", func(t *testing.T) {
+				list := newList(newFn, 1, 2, 1, 3, 1)
+				assert.Equal(t, 0, list.IndexOf(1))
+				assert.Equal(t, 3, list.IndexOf(3))
+				assert.Equal(t, -1, list.IndexOf(4))
+				assert.Equal(t, 3, list.CountOf(1))
+				assert.Equal(t, 1, list.CountOf(2))
+				assert.Equal(t, 0, list.CountOf(4))
+			})
+
+			t.Run("Update", func(t *testing.T) {
+				list := newList(newFn, 1, 2, 3)
+				assert.Nil(t, list.Update(0, 10))
+				assert.Nil(t, list.Update(2, 30))
+				assert.Error(t, list.Update(3, 40))
+				assert.Error(t, list.Update(-1, 40))
+				assert.Equal(t, []int{10, 2, 30}, toSlice(list))
+				assert.Equal(t, 3, list.Length())
+			})
+
+			t.Run("Iterate", func(t *testing.T) {
+				list := newList(newFn, 1, 2, 3)
+				var got []int
+				list.Iterate(func(v int) bool {
+					got = append(got, v)
+					return true
+				})
+				assert.Equal(t, []int{1, 2, 3}, got)
+
+				got = nil
+				list.Iterate(func(v int) bool {
+					got = append(got, v)
+					return v < 2
+				})
+				assert.Equal(t, []int{1, 2}, got)
+			})
+
+			t.Run("Reverse", func(t *testing.T) {
+				list := newList(newFn, 1)
+				list.Reverse()
+				assert.Equal(t, []int{1}, toSlice(list))
+				list.Append(2, 3)
+				list.Reverse()
+				assert.Equal(t, []int{3, 2, 1}, toSlice(list))
+				// the old head is the tail for the next Append
+				list.Append(0)
+				assert.Equal(t, []int{3, 2, 1, 0}, toSlice(list))
+				assert.Nil(t, list.InsertAfter(3, -1))
+				list.Reverse()
+				assert.Equal(t, []int{-1, 0, 1, 2, 3}, toSlice(list))
+			})
+
+			t.Run("ToSliceIsACopy", func(t *testing.T) {
+				list := newList(newFn, 1, 2, 3)
+				s := list.ToSlice()
+				s[0] = 10
+				assert.Equal(t, []int{1, 2, 3}, toSlice(list))
+			})
+		})
+	}
+}
+
+func TestAsList(t *testing.T) {
+	s := sslice.New(1, 2, 3)
+	list := AsList(s)
+	list.Append(4)
+	assert.Nil(t, list.InsertBefore(0, 0))
+	list.Remove(2)
+	assert.Equal(t, []int{0, 1, 3, 4}, s.Slice())
+	assert.Equal(t, s, s.AsList().Slice())
+}
@@ -0,0 +1,47 @@
+package scache
+
+import (
+	"github.com/chaseSpace/bear/sheap"
+	"github.com/chaseSpace/bear/slinkedlist"
+	"time"
+)
+
+// Stats holds the counters of a cache.
+type Stats struct {
+	Hits        uint64 // Get calls that found a live entry
+	Misses      uint64 // Get calls that found no entry, or an expired one
+	Evictions   uint64 // entries removed to stay within the capacity
+	Expirations uint64 // entries removed because they expired
+}
+
+// HitRate returns the ratio of hits to Get calls, or 0 if Get has not been called.
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// entry is a key-value pair in a cache. It is the value of the linked-list node that orders it,
+// so the node can be moved or removed in O(1).
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	node  *slinkedlist.DoublyNode[*entry[K, V]]
+
+	freq int // used by LFU
+
+	expires time.Time                   // used by TTLCache, zero if the entry does not expire
+	handle  *sheap.Handle[*entry[K, V]] // used by TTLCache, nil if the entry does not expire
+}
+
+// notify calls onEvict for the evicted entries. The caches call it after releasing their lock,
+// so that onEvict may use the cache.
+func notify[K comparable, V any](onEvict func(K, V), evicted []*entry[K, V]) {
+	if onEvict == nil {
+		return
+	}
+	for _, e := range evicted {
+		onEvict(e.key, e.value)
+	}
+}
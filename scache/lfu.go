@@ -0,0 +1,248 @@
+package scache
+
+import (
+	"github.com/chaseSpace/bear/slinkedlist"
+	"sort"
+	"sync"
+)
+
+// LFU is a cache that evicts the least frequently used entry when it is full, and the least recently used
+// one among those with the same frequency. Each Get or Set of a key counts as a use.
+// Its operations run in O(1), except Keys, and an eviction after Delete removed all the least frequently used
+// entries, which scans the distinct frequencies. It is safe for concurrent use by multiple goroutines.
+type LFU[K comparable, V any] struct {
+	mu       sync.Mutex
+	items    map[K]*entry[K, V]
+	buckets  map[int]*slinkedlist.DoublyLinkedList[*entry[K, V]] // the entries of each frequency, most recent first
+	minFreq  int
+	capacity int
+	onEvict  func(K, V)
+	stats    Stats
+}
+
+// NewLFU returns a new LFU that holds at most capacity entries. If capacity <= 0, it has no limit.
+func NewLFU[K comparable, V any](capacity int) *LFU[K, V] {
+	return &LFU[K, V]{
+		items:    make(map[K]*entry[K, V]),
+		buckets:  make(map[int]*slinkedlist.DoublyLinkedList[*entry[K, V]]),
+		capacity: capacity,
+	}
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// SetOnEvict sets f to be called with each entry that LFU evicts to stay within its capacity.
+// It is not called for the entries removed by Delete or Clear.
+func (c *LFU[K, V]) SetOnEvict(f func(key K, value V)) *LFU[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onEvict = f
+	return c
+}
+
+// Set sets the value of key, and counts it as a use of key.
+// If LFU is full, it evicts the least frequently used entry before adding key.
+func (c *LFU[K, V]) Set(key K, value V) *LFU[K, V] {
+	c.mu.Lock()
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		c.touch(e)
+		c.mu.Unlock()
+		return c
+	}
+	var evicted []*entry[K, V]
+	if c.capacity > 0 && len(c.items) >= c.capacity {
+		evicted = c.evict(len(c.items) - c.capacity + 1)
+	}
+	e := &entry[K, V]{key: key, value: value, freq: 1}
+	e.node = c.bucket(1).PushFront(e)
+	c.items[key] = e
+	c.minFreq = 1
+	onEvict := c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return c
+}
+
+// Resize changes the capacity of LFU, evicting the least frequently used entries if it shrinks.
+// If capacity <= 0, LFU has no limit.
+func (c *LFU[K, V]) Resize(capacity int) *LFU[K, V] {
+	c.mu.Lock()
+	c.capacity = capacity
+	var evicted []*entry[K, V]
+	if capacity > 0 && len(c.items) > capacity {
+		evicted = c.evict(len(c.items) - capacity)
+	}
+	onEvict := c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return c
+}
+
+// Clear removes all the entries from LFU. It keeps the stats.
+func (c *LFU[K, V]) Clear() *LFU[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items = make(map[K]*entry[K, V])
+	c.buckets = make(map[int]*slinkedlist.DoublyLinkedList[*entry[K, V]])
+	c.minFreq = 0
+	return c
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Get returns the value of key, and counts it as a use of key. The bool is false if key is not in LFU.
+func (c *LFU[K, V]) Get(key K) (value V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return value, false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Peek returns the value of key without counting it as a use or in the stats.
+// The bool is false if key is not in LFU.
+func (c *LFU[K, V]) Peek(key K) (value V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		return e.value, true
+	}
+	return value, false
+}
+
+// Has checks if key is in LFU, without counting it as a use.
+func (c *LFU[K, V]) Has(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.items[key]
+	return ok
+}
+
+// Frequency returns the number of uses of key, or 0 if key is not in LFU.
+func (c *LFU[K, V]) Frequency(key K) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		return e.freq
+	}
+	return 0
+}
+
+// Delete removes key from LFU. It returns false if key is not in LFU.
+func (c *LFU[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if ok {
+		c.unlink(e)
+		delete(c.items, key)
+	}
+	return ok
+}
+
+// Keys returns the keys in LFU, from the most to the least frequently used. The keys with the same frequency
+// are ordered from the most to the least recently used.
+func (c *LFU[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var freqs = make([]int, 0, len(c.buckets))
+	for freq := range c.buckets {
+		freqs = append(freqs, freq)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(freqs)))
+	var keys = make([]K, 0, len(c.items))
+	for _, freq := range freqs {
+		for node := c.buckets[freq].Front(); node != nil; node = node.Next() {
+			keys = append(keys, node.Value().key)
+		}
+	}
+	return keys
+}
+
+// Len returns the number of entries in LFU.
+func (c *LFU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Cap returns the capacity of LFU, which is 0 or less if it has no limit.
+func (c *LFU[K, V]) Cap() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.capacity
+}
+
+// Stats returns the counters of LFU.
+func (c *LFU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// ---------------------- internal ----------------------
+
+func (c *LFU[K, V]) bucket(freq int) *slinkedlist.DoublyLinkedList[*entry[K, V]] {
+	b, ok := c.buckets[freq]
+	if !ok {
+		b = slinkedlist.NewDoublyLinkedList[*entry[K, V]]()
+		c.buckets[freq] = b
+	}
+	return b
+}
+
+// touch moves e to the bucket of the next frequency.
+func (c *LFU[K, V]) touch(e *entry[K, V]) {
+	c.unlink(e)
+	if _, ok := c.buckets[e.freq]; !ok && e.freq == c.minFreq {
+		// e was the only entry of the least frequency, and it now has the next one
+		c.minFreq = e.freq + 1
+	}
+	e.freq++
+	e.node = c.bucket(e.freq).PushFront(e)
+}
+
+// unlink removes e from its bucket, and removes the bucket if it is empty.
+// minFreq may point to a removed bucket afterwards, but it is never greater than the least frequency,
+// so evict can find the least one.
+func (c *LFU[K, V]) unlink(e *entry[K, V]) {
+	b := c.buckets[e.freq]
+	b.RemoveNode(e.node)
+	if b.IsEmpty() {
+		delete(c.buckets, e.freq)
+	}
+}
+
+// evict removes n entries of the least frequency, and returns them.
+func (c *LFU[K, V]) evict(n int) (evicted []*entry[K, V]) {
+	for ; n > 0 && len(c.items) > 0; n-- {
+		b, ok := c.buckets[c.minFreq]
+		for !ok {
+			// Delete may have removed the bucket of minFreq
+			c.minFreq = c.leastFreq()
+			b, ok = c.buckets[c.minFreq]
+		}
+		e := b.Back().Value()
+		c.unlink(e)
+		delete(c.items, e.key)
+		c.stats.Evictions++
+		evicted = append(evicted, e)
+	}
+	return evicted
+}
+
+func (c *LFU[K, V]) leastFreq() int {
+	var least = -1
+	for freq := range c.buckets {
+		if least < 0 || freq < least {
+			least = freq
+		}
+	}
+	return least
+}
@@ -0,0 +1,138 @@
+package scache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLFU_EvictsLeastFrequentlyUsed(t *testing.T) {
+	var evicted []string
+	c := NewLFU[string, int](2).SetOnEvict(func(k string, _ int) { evicted = append(evicted, k) })
+	c.Set("a", 1).Set("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	assert.Equal(t, 3, c.Frequency("a"))
+	assert.Equal(t, 2, c.Frequency("b"))
+
+	c.Set("c", 3) // evicts b, the least frequently used
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, []string{"a", "c"}, c.Keys())
+
+	c.Set("d", 4) // evicts c, the new entry with frequency 1
+	assert.Equal(t, []string{"b", "c"}, evicted)
+	assert.Equal(t, 0, c.Frequency("c"))
+}
+
+func TestLFU_TiesEvictLeastRecentlyUsed(t *testing.T) {
+	c := NewLFU[int, int](3).Set(1, 1).Set(2, 2).Set(3, 3)
+	c.Get(1)
+	c.Get(2)
+	c.Get(3)
+	c.Get(2)
+	c.Get(1)
+	// 1 and 2 have frequency 3, 3 has 2
+	c.Set(4, 4)
+	assert.False(t, c.Has(3))
+	c.Get(4)
+	c.Get(4)
+	// all have frequency 3; 2 is the least recently used
+	c.Set(5, 5)
+	assert.False(t, c.Has(2))
+	assert.Equal(t, []int{4, 1, 5}, c.Keys())
+}
+
+func TestLFU_PeekAndSetCountAsUse(t *testing.T) {
+	c := NewLFU[int, string](2).Set(1, "a").Set(2, "b")
+	v, ok := c.Peek(1)
+	assert.True(t, ok)
+	assert.Equal(t, "a", v)
+	assert.Equal(t, 1, c.Frequency(1))
+
+	c.Set(2, "bb")
+	assert.Equal(t, 2, c.Frequency(2))
+	c.Set(3, "c")
+	assert.False(t, c.Has(1))
+	assert.Equal(t, Stats{Evictions: 1}, c.Stats())
+}
+
+func TestLFU_EvictAfterDelete(t *testing.T) {
+	c := NewLFU[int, int](3).Set(1, 1).Set(2, 2).Set(3, 3)
+	c.Get(2)
+	c.Get(3)
+	c.Get(3)
+	c.Get(3)
+	// the only entry of the least frequency is deleted, so minFreq is stale
+	assert.True(t, c.Delete(1))
+	assert.False(t, c.Delete(1))
+	c.Get(3)
+	c.Set(4, 4).Set(5, 5)
+	// 4 has frequency 1 and is the least frequently used
+	assert.False(t, c.Has(4))
+	assert.True(t, c.Has(2))
+	assert.True(t, c.Has(3))
+	assert.True(t, c.Has(5))
+
+	c.Resize(1)
+	assert.Equal(t, []int{3}, c.Keys())
+	v, ok := c.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	_, ok = c.Get(2)
+	assert.False(t, ok)
+	assert.Equal(t, uint64(1), c.Stats().Misses)
+
+	c.Clear()
+	assert.Equal(t, 0, c.Len())
+	c.Set(1, 1)
+	assert.Equal(t, 1, c.Frequency(1))
+}
+
+// TestLFU_MatchesReference compares LFU with a simple O(n) implementation.
+func TestLFU_MatchesReference(t *testing.T) {
+	const capacity = 5
+	c := NewLFU[int, int](capacity)
+	type ref struct{ freq, last int }
+	refs := map[int]*ref{}
+	clock := 0
+	use := func(k int) {
+		clock++
+		if r, ok := refs[k]; ok {
+			r.freq++
+			r.last = clock
+			return
+		}
+		if len(refs) == capacity {
+			victim, best := 0, (*ref)(nil)
+			for key, r := range refs {
+				if best == nil || r.freq < best.freq || (r.freq == best.freq && r.last < best.last) {
+					victim, best = key, r
+				}
+			}
+			delete(refs, victim)
+		}
+		refs[k] = &ref{freq: 1, last: clock}
+	}
+	for i := 0; i < 2000; i++ {
+		k := (i * 7919) % 13
+		switch {
+		case i%11 == 0:
+			c.Delete(k)
+			delete(refs, k)
+		case i%3 == 0:
+			_, ok := c.Get(k)
+			_, want := refs[k]
+			assert.Equal(t, want, ok)
+			if ok {
+				use(k)
+			}
+		default:
+			c.Set(k, i)
+			use(k)
+		}
+		for k, r := range refs {
+			assert.Equal(t, r.freq, c.Frequency(k))
+		}
+		assert.Equal(t, len(refs), c.Len())
+	}
+}
@@ -0,0 +1,169 @@
+package scache
+
+import (
+	"github.com/chaseSpace/bear/slinkedlist"
+	"sync"
+)
+
+// LRU is a cache that evicts the least recently used entry when it is full.
+// All its operations run in O(1), and it is safe for concurrent use by multiple goroutines.
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	items    map[K]*entry[K, V]
+	order    *slinkedlist.DoublyLinkedList[*entry[K, V]] // from the most to the least recently used
+	capacity int
+	onEvict  func(K, V)
+	stats    Stats
+}
+
+// NewLRU returns a new LRU that holds at most capacity entries. If capacity <= 0, it has no limit.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	return &LRU[K, V]{
+		items:    make(map[K]*entry[K, V]),
+		order:    slinkedlist.NewDoublyLinkedList[*entry[K, V]](),
+		capacity: capacity,
+	}
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// SetOnEvict sets f to be called with each entry that LRU evicts to stay within its capacity.
+// It is not called for the entries removed by Delete or Clear.
+func (c *LRU[K, V]) SetOnEvict(f func(key K, value V)) *LRU[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onEvict = f
+	return c
+}
+
+// Set sets the value of key, and marks it as the most recently used.
+// If LRU is full, it evicts the least recently used entry.
+func (c *LRU[K, V]) Set(key K, value V) *LRU[K, V] {
+	c.mu.Lock()
+	if e, ok := c.items[key]; ok {
+		e.value = value
+		c.order.MoveToFront(e.node)
+		c.mu.Unlock()
+		return c
+	}
+	e := &entry[K, V]{key: key, value: value}
+	e.node = c.order.PushFront(e)
+	c.items[key] = e
+	evicted, onEvict := c.evict(), c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return c
+}
+
+// Resize changes the capacity of LRU, evicting the least recently used entries if it shrinks.
+// If capacity <= 0, LRU has no limit.
+func (c *LRU[K, V]) Resize(capacity int) *LRU[K, V] {
+	c.mu.Lock()
+	c.capacity = capacity
+	evicted, onEvict := c.evict(), c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return c
+}
+
+// Clear removes all the entries from LRU. It keeps the stats.
+func (c *LRU[K, V]) Clear() *LRU[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items = make(map[K]*entry[K, V])
+	c.order = slinkedlist.NewDoublyLinkedList[*entry[K, V]]()
+	return c
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Get returns the value of key, and marks it as the most recently used. The bool is false if key is not in LRU.
+func (c *LRU[K, V]) Get(key K) (value V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return value, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(e.node)
+	return e.value, true
+}
+
+// Peek returns the value of key without marking it as used or counting it in the stats.
+// The bool is false if key is not in LRU.
+func (c *LRU[K, V]) Peek(key K) (value V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[key]; ok {
+		return e.value, true
+	}
+	return value, false
+}
+
+// Has checks if key is in LRU, without marking it as used.
+func (c *LRU[K, V]) Has(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.items[key]
+	return ok
+}
+
+// Delete removes key from LRU. It returns false if key is not in LRU.
+func (c *LRU[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if ok {
+		c.order.RemoveNode(e.node)
+		delete(c.items, key)
+	}
+	return ok
+}
+
+// Keys returns the keys in LRU, from the most to the least recently used.
+func (c *LRU[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var keys = make([]K, 0, len(c.items))
+	for node := c.order.Front(); node != nil; node = node.Next() {
+		keys = append(keys, node.Value().key)
+	}
+	return keys
+}
+
+// Len returns the number of entries in LRU.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Cap returns the capacity of LRU, which is 0 or less if it has no limit.
+func (c *LRU[K, V]) Cap() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.capacity
+}
+
+// Stats returns the counters of LRU.
+func (c *LRU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// ---------------------- internal ----------------------
+
+// evict removes the least recently used entries until LRU is within its capacity, and returns them.
+func (c *LRU[K, V]) evict() (evicted []*entry[K, V]) {
+	for c.capacity > 0 && len(c.items) > c.capacity {
+		e := c.order.Back().Value()
+		c.order.RemoveNode(e.node)
+		delete(c.items, e.key)
+		c.stats.Evictions++
+		evicted = append(evicted, e)
+	}
+	return evicted
+}
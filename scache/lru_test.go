@@ -0,0 +1,104 @@
+package scache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestLRU_SetGetEvict(t *testing.T) {
+	var evicted []string
+	c := NewLRU[string, int](2).SetOnEvict(func(k string, v int) {
+		evicted = append(evicted, k+"="+strconv.Itoa(v))
+	})
+	c.Set("a", 1).Set("b", 2)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	c.Set("c", 3) // evicts b, the least recently used
+	assert.Equal(t, []string{"b=2"}, evicted)
+	assert.False(t, c.Has("b"))
+	assert.Equal(t, []string{"c", "a"}, c.Keys())
+
+	c.Set("a", 10) // updates and promotes a
+	c.Set("d", 4)  // evicts c
+	assert.Equal(t, []string{"b=2", "c=3"}, evicted)
+	assert.Equal(t, []string{"d", "a"}, c.Keys())
+	v, _ = c.Peek("a")
+	assert.Equal(t, 10, v)
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, 2, c.Cap())
+}
+
+func TestLRU_PeekDoesNotPromote(t *testing.T) {
+	c := NewLRU[int, int](2).Set(1, 1).Set(2, 2)
+	_, ok := c.Peek(1)
+	assert.True(t, ok)
+	assert.True(t, c.Has(1))
+	c.Set(3, 3)
+	assert.False(t, c.Has(1))
+	_, ok = c.Peek(1)
+	assert.False(t, ok)
+	assert.Equal(t, Stats{Evictions: 1}, c.Stats())
+}
+
+func TestLRU_Stats(t *testing.T) {
+	c := NewLRU[int, int](0).Set(1, 1)
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+	stats := c.Stats()
+	assert.Equal(t, Stats{Hits: 2, Misses: 1}, stats)
+	assert.InDelta(t, 2.0/3, stats.HitRate(), 1e-9)
+	assert.Equal(t, 0.0, Stats{}.HitRate())
+}
+
+func TestLRU_DeleteClearResize(t *testing.T) {
+	var evicted []int
+	c := NewLRU[int, string](0).SetOnEvict(func(k int, _ string) { evicted = append(evicted, k) })
+	for i := 0; i < 5; i++ {
+		c.Set(i, strconv.Itoa(i))
+	}
+	assert.Equal(t, 5, c.Len())
+	assert.True(t, c.Delete(0))
+	assert.False(t, c.Delete(0))
+
+	c.Resize(2)
+	assert.Equal(t, []int{1, 2}, evicted)
+	assert.Equal(t, []int{4, 3}, c.Keys())
+
+	c.Clear()
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, []int{1, 2}, evicted)
+	c.Set(9, "9")
+	assert.Equal(t, []int{9}, c.Keys())
+}
+
+func TestLRU_OnEvictCanUseCache(t *testing.T) {
+	c := NewLRU[int, int](1)
+	c.SetOnEvict(func(k, v int) {
+		// the callback runs without the lock held
+		assert.False(t, c.Has(k))
+	})
+	c.Set(1, 1).Set(2, 2)
+}
+
+func TestLRU_Concurrent(t *testing.T) {
+	c := NewLRU[int, int](64)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Set(i%100, g)
+				c.Get(i % 50)
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 64, c.Len())
+	assert.Len(t, c.Keys(), 64)
+}
@@ -0,0 +1,288 @@
+package scache
+
+import (
+	"github.com/chaseSpace/bear/sheap"
+	"github.com/chaseSpace/bear/slinkedlist"
+	"sync"
+	"time"
+)
+
+// TTLCache is a cache whose entries expire after a time-to-live. Expired entries are never returned,
+// and they are removed when they are accessed, and before any operation that counts or lists the entries.
+// When it is full, it first removes the expired entries, then evicts the least recently used one.
+//
+// It keeps the entries in a heap ordered by expiry, so removing the expired entries takes O(log n) each,
+// and it is safe for concurrent use by multiple goroutines.
+type TTLCache[K comparable, V any] struct {
+	mu       sync.Mutex
+	items    map[K]*entry[K, V]
+	order    *slinkedlist.DoublyLinkedList[*entry[K, V]] // from the most to the least recently used
+	expiry   *sheap.Heap[*entry[K, V]]                   // the entries that expire, the earliest on the top
+	ttl      time.Duration
+	capacity int
+	now      func() time.Time
+	onEvict  func(K, V)
+	stats    Stats
+}
+
+// NewTTL returns a new TTLCache that holds at most capacity entries, each expiring ttl after it is set.
+// If capacity <= 0, it has no limit. If ttl <= 0, the entries do not expire unless set by SetWithTTL.
+func NewTTL[K comparable, V any](capacity int, ttl time.Duration) *TTLCache[K, V] {
+	return &TTLCache[K, V]{
+		items:    make(map[K]*entry[K, V]),
+		order:    slinkedlist.NewDoublyLinkedList[*entry[K, V]](),
+		expiry:   sheap.New(expiresBefore[K, V]),
+		ttl:      ttl,
+		capacity: capacity,
+		now:      time.Now,
+	}
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// SetOnEvict sets f to be called with each entry that TTLCache removes because it expired, or to stay within
+// its capacity. It is not called for the entries removed by Delete or Clear.
+func (c *TTLCache[K, V]) SetOnEvict(f func(key K, value V)) *TTLCache[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onEvict = f
+	return c
+}
+
+// SetClock sets the function that TTLCache uses to get the current time, which is time.Now by default.
+// Tests can pass a fake clock to expire entries without sleeping.
+func (c *TTLCache[K, V]) SetClock(now func() time.Time) *TTLCache[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+	return c
+}
+
+// Set sets the value of key with the default TTL, and marks it as the most recently used.
+func (c *TTLCache[K, V]) Set(key K, value V) *TTLCache[K, V] {
+	return c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL sets the value of key, which expires ttl later, and marks it as the most recently used.
+// If ttl <= 0, the entry does not expire.
+func (c *TTLCache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) *TTLCache[K, V] {
+	c.mu.Lock()
+	now := c.now()
+	var expires time.Time
+	if ttl > 0 {
+		expires = now.Add(ttl)
+	}
+
+	e, ok := c.items[key]
+	if ok {
+		e.value = value
+		c.order.MoveToFront(e.node)
+		c.setExpiry(e, expires)
+		c.mu.Unlock()
+		return c
+	}
+	e = &entry[K, V]{key: key, value: value}
+	e.node = c.order.PushFront(e)
+	c.items[key] = e
+	c.setExpiry(e, expires)
+
+	var evicted []*entry[K, V]
+	if c.capacity > 0 && len(c.items) > c.capacity {
+		evicted = c.removeExpired(now)
+		for len(c.items) > c.capacity {
+			last := c.order.Back().Value()
+			c.remove(last)
+			c.stats.Evictions++
+			evicted = append(evicted, last)
+		}
+	}
+	onEvict := c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return c
+}
+
+// Clear removes all the entries from TTLCache. It keeps the stats.
+func (c *TTLCache[K, V]) Clear() *TTLCache[K, V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items = make(map[K]*entry[K, V])
+	c.order = slinkedlist.NewDoublyLinkedList[*entry[K, V]]()
+	c.expiry.Clear()
+	return c
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Get returns the value of key, and marks it as the most recently used.
+// The bool is false if key is not in TTLCache or has expired.
+func (c *TTLCache[K, V]) Get(key K) (value V, ok bool) {
+	if e := c.lookup(key, true); e != nil {
+		return e.value, true
+	}
+	return value, false
+}
+
+// Peek returns the value of key without marking it as used or counting it in the stats.
+// The bool is false if key is not in TTLCache or has expired.
+func (c *TTLCache[K, V]) Peek(key K) (value V, ok bool) {
+	if e := c.lookup(key, false); e != nil {
+		return e.value, true
+	}
+	return value, false
+}
+
+// Has checks if key is in TTLCache and has not expired, without marking it as used.
+func (c *TTLCache[K, V]) Has(key K) bool {
+	return c.lookup(key, false) != nil
+}
+
+// TTL returns how long key lives before it expires. The bool is false if key is not in TTLCache or has expired.
+// The duration is 0 if key does not expire.
+func (c *TTLCache[K, V]) TTL(key K) (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return 0, false
+	}
+	if e.handle == nil {
+		return 0, true
+	}
+	left := e.expires.Sub(c.now())
+	return left, left > 0
+}
+
+// Delete removes key from TTLCache. It returns false if key is not in TTLCache or has expired.
+func (c *TTLCache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	live := !c.expired(e, c.now())
+	c.remove(e)
+	return live
+}
+
+// DeleteExpired removes the expired entries from TTLCache, and returns how many it removed.
+// The other methods remove expired entries as they go, so calling it is only needed to release memory sooner.
+func (c *TTLCache[K, V]) DeleteExpired() int {
+	c.mu.Lock()
+	evicted, onEvict := c.removeExpired(c.now()), c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return len(evicted)
+}
+
+// Keys returns the keys in TTLCache that have not expired, from the most to the least recently used.
+func (c *TTLCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	evicted := c.removeExpired(c.now())
+	var keys = make([]K, 0, len(c.items))
+	for node := c.order.Front(); node != nil; node = node.Next() {
+		keys = append(keys, node.Value().key)
+	}
+	onEvict := c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return keys
+}
+
+// Len returns the number of entries in TTLCache that have not expired.
+func (c *TTLCache[K, V]) Len() int {
+	c.mu.Lock()
+	evicted := c.removeExpired(c.now())
+	n, onEvict := len(c.items), c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return n
+}
+
+// Cap returns the capacity of TTLCache, which is 0 or less if it has no limit.
+func (c *TTLCache[K, V]) Cap() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.capacity
+}
+
+// Stats returns the counters of TTLCache.
+func (c *TTLCache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// ---------------------- internal ----------------------
+
+func expiresBefore[K comparable, V any](a, b *entry[K, V]) bool {
+	return a.expires.Before(b.expires)
+}
+
+func (c *TTLCache[K, V]) expired(e *entry[K, V], now time.Time) bool {
+	return e.handle != nil && !now.Before(e.expires)
+}
+
+// lookup returns the live entry of key, or nil. An expired entry is removed.
+// If use is true, the entry is marked as the most recently used and the lookup is counted in the stats.
+func (c *TTLCache[K, V]) lookup(key K, use bool) *entry[K, V] {
+	c.mu.Lock()
+	e, ok := c.items[key]
+	var evicted []*entry[K, V]
+	if ok && c.expired(e, c.now()) {
+		c.remove(e)
+		c.stats.Expirations++
+		evicted = append(evicted, e)
+		e = nil
+	}
+	if use {
+		if e == nil {
+			c.stats.Misses++
+		} else {
+			c.stats.Hits++
+			c.order.MoveToFront(e.node)
+		}
+	}
+	onEvict := c.onEvict
+	c.mu.Unlock()
+	notify(onEvict, evicted)
+	return e
+}
+
+// setExpiry sets the expiry time of e, which is zero if e does not expire.
+func (c *TTLCache[K, V]) setExpiry(e *entry[K, V], expires time.Time) {
+	e.expires = expires
+	switch {
+	case expires.IsZero() && e.handle != nil:
+		_ = c.expiry.Remove(e.handle)
+		e.handle = nil
+	case !expires.IsZero() && e.handle != nil:
+		_ = c.expiry.Update(e.handle, e)
+	case !expires.IsZero():
+		e.handle = c.expiry.PushHandle(e)
+	}
+}
+
+// remove removes e from TTLCache.
+func (c *TTLCache[K, V]) remove(e *entry[K, V]) {
+	c.order.RemoveNode(e.node)
+	if e.handle != nil {
+		_ = c.expiry.Remove(e.handle)
+		e.handle = nil
+	}
+	delete(c.items, e.key)
+}
+
+// removeExpired removes the entries that have expired at now, and returns them.
+func (c *TTLCache[K, V]) removeExpired(now time.Time) (evicted []*entry[K, V]) {
+	for {
+		e, ok := c.expiry.Peek()
+		if !ok || now.Before(e.expires) {
+			return evicted
+		}
+		c.remove(e)
+		c.stats.Expirations++
+		evicted = append(evicted, e)
+	}
+}
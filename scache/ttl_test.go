@@ -0,0 +1,139 @@
+package scache
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock that only moves when told to.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestTTLCache_Expires(t *testing.T) {
+	clock := newFakeClock()
+	var expired []string
+	c := NewTTL[string, int](0, time.Minute).SetClock(clock.Now).SetOnEvict(func(k string, _ int) {
+		expired = append(expired, k)
+	})
+	c.Set("a", 1)
+	clock.Advance(30 * time.Second)
+	c.Set("b", 2)
+
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	left, ok := c.TTL("a")
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, left)
+
+	clock.Advance(30 * time.Second)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"a"}, expired)
+	assert.True(t, c.Has("b"))
+	assert.Equal(t, 1, c.Len())
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, []string{"a", "b"}, expired)
+	assert.Equal(t, Stats{Hits: 1, Misses: 1, Expirations: 2}, c.Stats())
+}
+
+func TestTTLCache_SetRefreshesTTL(t *testing.T) {
+	clock := newFakeClock()
+	c := NewTTL[int, int](0, time.Minute).SetClock(clock.Now)
+	c.Set(1, 1)
+	clock.Advance(50 * time.Second)
+	c.Set(1, 2)
+	clock.Advance(50 * time.Second)
+	v, ok := c.Peek(1)
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	// Peek does not count in the stats
+	assert.Equal(t, Stats{}, c.Stats())
+}
+
+func TestTTLCache_SetWithTTL(t *testing.T) {
+	clock := newFakeClock()
+	c := NewTTL[string, int](0, 0).SetClock(clock.Now)
+	c.Set("forever", 1)
+	c.SetWithTTL("short", 2, time.Second)
+	c.SetWithTTL("long", 3, time.Hour)
+	left, ok := c.TTL("forever")
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), left)
+
+	clock.Advance(time.Minute)
+	assert.Equal(t, []string{"long", "forever"}, c.Keys())
+
+	// an entry that expired can be made permanent again
+	c.SetWithTTL("long", 4, 0)
+	clock.Advance(2 * time.Hour)
+	assert.Equal(t, []string{"long", "forever"}, c.Keys())
+	assert.Equal(t, 0, c.DeleteExpired())
+	_, ok = c.TTL("short")
+	assert.False(t, ok)
+}
+
+func TestTTLCache_CapacityPrefersExpired(t *testing.T) {
+	clock := newFakeClock()
+	var evicted []string
+	c := NewTTL[string, int](2, time.Hour).SetClock(clock.Now).SetOnEvict(func(k string, _ int) {
+		evicted = append(evicted, k)
+	})
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, time.Second)
+	c.Get("a")
+	clock.Advance(time.Minute)
+	// b has expired, so it is removed instead of evicting a
+	c.Set("c", 3)
+	assert.Equal(t, []string{"b"}, evicted)
+	assert.Equal(t, Stats{Hits: 1, Expirations: 1}, c.Stats())
+
+	// nothing expired, so the least recently used a is evicted
+	c.Set("d", 4)
+	assert.Equal(t, []string{"b", "a"}, evicted)
+	assert.Equal(t, []string{"d", "c"}, c.Keys())
+	assert.Equal(t, uint64(1), c.Stats().Evictions)
+	assert.Equal(t, 2, c.Cap())
+}
+
+func TestTTLCache_DeleteAndClear(t *testing.T) {
+	clock := newFakeClock()
+	c := NewTTL[int, int](0, time.Second).SetClock(clock.Now)
+	c.Set(1, 1).Set(2, 2)
+	assert.True(t, c.Delete(1))
+	assert.False(t, c.Delete(1))
+	clock.Advance(time.Second)
+	// an expired entry is removed, but reported as not present
+	assert.False(t, c.Delete(2))
+
+	c.Set(3, 3).Set(4, 4)
+	c.Clear()
+	assert.Equal(t, 0, c.Len())
+	clock.Advance(time.Hour)
+	assert.Equal(t, 0, c.DeleteExpired())
+
+	c.Set(5, 5)
+	clock.Advance(time.Second)
+	assert.Equal(t, 1, c.DeleteExpired())
+}
+
+func TestTTLCache_DefaultClock(t *testing.T) {
+	c := NewTTL[int, int](1, time.Hour).Set(1, 1)
+	assert.True(t, c.Has(1))
+}
@@ -0,0 +1,225 @@
+package sheap
+
+import (
+	"fmt"
+	"github.com/chaseSpace/bear/constraints"
+	"github.com/chaseSpace/bear/sslice"
+)
+
+// Heap is a binary heap, which can be used as a priority queue.
+// The element at the top is the one that is "less" than all others according to the less function,
+// so a heap created with Less is a min-heap, and one created with Greater is a max-heap.
+type Heap[T any] struct {
+	items []*Handle[T]
+	less  func(a, b T) bool
+}
+
+// Handle refers to an element in Heap. It can be used to update or remove the element in O(log n).
+type Handle[T any] struct {
+	value T
+	index int // -1 if the element is no longer in the heap
+	heap  *Heap[T]
+}
+
+// Value returns the value of the element.
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// Less is the less function of a min-heap for ordered types.
+func Less[T constraints.Ordered](a, b T) bool {
+	return a < b
+}
+
+// Greater is the less function of a max-heap for ordered types.
+func Greater[T constraints.Ordered](a, b T) bool {
+	return a > b
+}
+
+// New creates a new Heap ordered by the less function, and heapifies items into it in O(n).
+func New[T any](less func(a, b T) bool, items ...T) *Heap[T] {
+	var h = &Heap[T]{less: less, items: make([]*Handle[T], 0, len(items))}
+	for _, item := range items {
+		h.items = append(h.items, &Handle[T]{value: item, index: len(h.items), heap: h})
+	}
+	h.heapify()
+	return h
+}
+
+// NewMin creates a new min-heap for ordered types.
+func NewMin[T constraints.Ordered](items ...T) *Heap[T] {
+	return New(Less[T], items...)
+}
+
+// NewMax creates a new max-heap for ordered types.
+func NewMax[T constraints.Ordered](items ...T) *Heap[T] {
+	return New(Greater[T], items...)
+}
+
+// FromSlice creates a new Heap ordered by the less function from the elements of Slice in O(n).
+// The Slice is not modified.
+func FromSlice[T comparable](s *sslice.Slice[T], less func(a, b T) bool) *Heap[T] {
+	return New(less, s.Slice()...)
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Push pushes items into Heap.
+func (h *Heap[T]) Push(items ...T) *Heap[T] {
+	for _, item := range items {
+		h.PushHandle(item)
+	}
+	return h
+}
+
+// Merge pushes all the elements of other into Heap. The other Heap is not modified.
+func (h *Heap[T]) Merge(other *Heap[T]) *Heap[T] {
+	for _, item := range other.items {
+		h.items = append(h.items, &Handle[T]{value: item.value, index: len(h.items), heap: h})
+	}
+	h.heapify()
+	return h
+}
+
+// Clear removes all elements from Heap.
+func (h *Heap[T]) Clear() *Heap[T] {
+	for _, item := range h.items {
+		item.index = -1
+	}
+	h.items = nil
+	return h
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// PushHandle pushes item into Heap, and returns its Handle.
+func (h *Heap[T]) PushHandle(item T) *Handle[T] {
+	var handle = &Handle[T]{value: item, index: len(h.items), heap: h}
+	h.items = append(h.items, handle)
+	h.up(handle.index)
+	return handle
+}
+
+// Pop removes and returns the top element of Heap. The bool is false if Heap is empty.
+func (h *Heap[T]) Pop() (top T, ok bool) {
+	if len(h.items) == 0 {
+		return
+	}
+	return h.removeAt(0), true
+}
+
+// Peek returns the top element of Heap without removing it. The bool is false if Heap is empty.
+func (h *Heap[T]) Peek() (top T, ok bool) {
+	if len(h.items) == 0 {
+		return
+	}
+	return h.items[0].value, true
+}
+
+// Update changes the value of the element referred by handle, and restores the heap order.
+func (h *Heap[T]) Update(handle *Handle[T], value T) error {
+	if !h.owns(handle) {
+		return fmt.Errorf("handle is not in the heap")
+	}
+	handle.value = value
+	if !h.down(handle.index) {
+		h.up(handle.index)
+	}
+	return nil
+}
+
+// Remove removes the element referred by handle from Heap.
+func (h *Heap[T]) Remove(handle *Handle[T]) error {
+	if !h.owns(handle) {
+		return fmt.Errorf("handle is not in the heap")
+	}
+	h.removeAt(handle.index)
+	return nil
+}
+
+// Len returns the number of elements in Heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// IsEmpty checks if Heap is empty.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Slice returns a copy of the elements in Heap, in the order of the underlying array rather than sorted.
+func (h *Heap[T]) Slice() []T {
+	var copied = make([]T, 0, len(h.items))
+	for _, item := range h.items {
+		copied = append(copied, item.value)
+	}
+	return copied
+}
+
+// ---------------------- internal ----------------------
+
+func (h *Heap[T]) owns(handle *Handle[T]) bool {
+	return handle != nil && handle.heap == h && handle.index >= 0 && handle.index < len(h.items) &&
+		h.items[handle.index] == handle
+}
+
+func (h *Heap[T]) heapify() {
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+func (h *Heap[T]) removeAt(i int) T {
+	var removed = h.items[i]
+	var last = len(h.items) - 1
+	if i != last {
+		h.swap(i, last)
+	}
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if i != last && !h.down(i) {
+		h.up(i)
+	}
+	removed.index = -1
+	return removed.value
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+// up moves the element at i up until its parent is not greater than it.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i].value, h.items[parent].value) {
+			break
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the element at i down until its children are not less than it.
+// It returns true if the element has been moved.
+func (h *Heap[T]) down(i int) bool {
+	var start = i
+	var n = len(h.items)
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.less(h.items[right].value, h.items[child].value) {
+			child = right
+		}
+		if !h.less(h.items[child].value, h.items[i].value) {
+			break
+		}
+		h.swap(i, child)
+		i = child
+	}
+	return i > start
+}
@@ -0,0 +1,130 @@
+package sheap
+
+import (
+	"github.com/chaseSpace/bear/sslice"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func popAll[T any](h *Heap[T]) []T {
+	var result []T
+	for !h.IsEmpty() {
+		v, _ := h.Pop()
+		result = append(result, v)
+	}
+	return result
+}
+
+func TestNewMin_PopsInAscendingOrder(t *testing.T) {
+	h := NewMin(5, 2, 8, 1, 9, 2)
+	assert.Equal(t, 6, h.Len())
+	assert.Equal(t, []int{1, 2, 2, 5, 8, 9}, popAll(h))
+}
+
+func TestNewMax_PopsInDescendingOrder(t *testing.T) {
+	h := NewMax("b", "c", "a")
+	top, ok := h.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "c", top)
+	assert.Equal(t, []string{"c", "b", "a"}, popAll(h))
+}
+
+func TestHeap_EmptyHeap_PopAndPeekReturnFalse(t *testing.T) {
+	h := NewMin[int]()
+	_, ok := h.Pop()
+	assert.False(t, ok)
+	_, ok = h.Peek()
+	assert.False(t, ok)
+}
+
+func TestNew_Comparator_OrdersStructs(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	h := New(func(a, b task) bool { return a.priority > b.priority })
+	h.Push(task{"low", 1}, task{"high", 10}, task{"mid", 5})
+	top, _ := h.Pop()
+	assert.Equal(t, "high", top.name)
+	top, _ = h.Pop()
+	assert.Equal(t, "mid", top.name)
+}
+
+func TestHeap_Update_RestoresOrder(t *testing.T) {
+	h := NewMin(5, 6, 7)
+	a := h.PushHandle(10)
+	b := h.PushHandle(1)
+	assert.Equal(t, 10, a.Value())
+
+	assert.NoError(t, h.Update(a, 0)) // move up
+	top, _ := h.Peek()
+	assert.Equal(t, 0, top)
+
+	assert.NoError(t, h.Update(a, 100)) // move down
+	assert.NoError(t, h.Update(b, 6))
+	assert.Equal(t, []int{5, 6, 6, 7, 100}, popAll(h))
+}
+
+func TestHeap_Remove_ByHandle(t *testing.T) {
+	h := NewMin(3, 4)
+	a := h.PushHandle(1)
+	b := h.PushHandle(2)
+	assert.NoError(t, h.Remove(b))
+	assert.Error(t, h.Remove(b), "a removed handle can not be removed again")
+	assert.Error(t, h.Update(b, 0))
+	assert.NoError(t, h.Remove(a))
+	assert.Equal(t, []int{3, 4}, popAll(h))
+
+	// a handle of a popped element is invalid
+	c := h.PushHandle(1)
+	h.Pop()
+	assert.Error(t, h.Remove(c))
+	// a handle of another heap is invalid
+	assert.Error(t, NewMin[int]().Remove(h.PushHandle(1)))
+}
+
+func TestHeap_Merge_KeepsOtherUnchanged(t *testing.T) {
+	h := NewMin(5, 1)
+	other := NewMin(4, 2, 3)
+	h.Merge(other)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, popAll(h))
+	assert.Equal(t, 3, other.Len())
+}
+
+func TestFromSlice_Heapifies(t *testing.T) {
+	s := sslice.New(3, 1, 2)
+	h := FromSlice(s, Greater[int])
+	assert.Equal(t, []int{3, 2, 1}, popAll(h))
+	assert.Equal(t, []int{3, 1, 2}, s.Slice())
+}
+
+func TestHeap_Clear(t *testing.T) {
+	h := NewMin(1, 2)
+	handle := h.PushHandle(3)
+	h.Clear()
+	assert.True(t, h.IsEmpty())
+	assert.Error(t, h.Remove(handle))
+}
+
+func TestHeap_RandomOperations_MatchSortedReference(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewMin[int]()
+	var handles []*Handle[int]
+	for i := 0; i < 1000; i++ {
+		handles = append(handles, h.PushHandle(r.Intn(500)))
+	}
+	for i := 0; i < 300; i++ {
+		_ = h.Update(handles[r.Intn(len(handles))], r.Intn(500))
+		_ = h.Remove(handles[r.Intn(len(handles))])
+	}
+	var expected []int
+	for _, handle := range handles {
+		if handle.index >= 0 {
+			expected = append(expected, handle.Value())
+		}
+	}
+	sort.Ints(expected)
+	assert.Equal(t, expected, popAll(h))
+}
@@ -0,0 +1,108 @@
+package slinkedlist
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v3"
+)
+
+// The linked lists are encoded as a plain list of their values, from head to tail.
+
+// MarshalJSON implements json.Marshaler.
+func (list *SinglyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(list.values())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the values in the linked list.
+func (list *SinglyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	list.clear()
+	list.Append(values...)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (list *SinglyLinkedList[T]) MarshalYAML() (interface{}, error) {
+	return list.values(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the values in the linked list.
+func (list *SinglyLinkedList[T]) UnmarshalYAML(value *yaml.Node) error {
+	var values []T
+	if err := value.Decode(&values); err != nil {
+		return err
+	}
+	list.clear()
+	list.Append(values...)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of the linked list.
+func (list *SinglyLinkedList[T]) MarshalText() ([]byte, error) {
+	return list.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of the linked list.
+func (list *SinglyLinkedList[T]) UnmarshalText(text []byte) error {
+	return list.UnmarshalJSON(text)
+}
+
+func (list *SinglyLinkedList[T]) values() []T {
+	var values = []T{}
+	for current := list.head; current != nil; current = current.next {
+		values = append(values, current.val)
+	}
+	return values
+}
+
+// MarshalJSON implements json.Marshaler.
+func (list *DoublyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(list.values())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the values in the linked list.
+func (list *DoublyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	list.clear()
+	list.Append(values...)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (list *DoublyLinkedList[T]) MarshalYAML() (interface{}, error) {
+	return list.values(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the values in the linked list.
+func (list *DoublyLinkedList[T]) UnmarshalYAML(value *yaml.Node) error {
+	var values []T
+	if err := value.Decode(&values); err != nil {
+		return err
+	}
+	list.clear()
+	list.Append(values...)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of the linked list.
+func (list *DoublyLinkedList[T]) MarshalText() ([]byte, error) {
+	return list.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of the linked list.
+func (list *DoublyLinkedList[T]) UnmarshalText(text []byte) error {
+	return list.UnmarshalJSON(text)
+}
+
+func (list *DoublyLinkedList[T]) values() []T {
+	var values = []T{}
+	for current := list.head; current != nil; current = current.next {
+		values = append(values, current.val)
+	}
+	return values
+}
@@ -0,0 +1,64 @@
+package slinkedlist
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+type codecConfig struct {
+	Steps   *SinglyLinkedList[string] `json:"steps" yaml:"steps"`
+	History *DoublyLinkedList[int]    `json:"history" yaml:"history"`
+}
+
+func TestLinkedList_JSON(t *testing.T) {
+	var config = codecConfig{Steps: NewSinglyLinkedList[string](), History: NewDoublyLinkedList[int]()}
+	config.Steps.Append("b", "a")
+	data, err := json.Marshal(config)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"steps":["b","a"],"history":[]}`, string(data))
+
+	var decoded codecConfig
+	assert.Nil(t, json.Unmarshal([]byte(`{"steps":["x","y"],"history":[3,2,1]}`), &decoded))
+	assert.Equal(t, []string{"x", "y"}, decoded.Steps.values())
+	assert.Equal(t, []int{3, 2, 1}, decoded.History.values())
+	assert.Equal(t, 1, decoded.History.tail.val)
+	assert.Equal(t, 2, decoded.History.tail.prev.val)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"history":["a"]}`), &decoded))
+}
+
+func TestLinkedList_UnmarshalJSON_ReplacesValues(t *testing.T) {
+	var list = NewSinglyLinkedList[int]()
+	list.Append(1, 2)
+	assert.Nil(t, json.Unmarshal([]byte(`[7]`), list))
+	assert.Equal(t, []int{7}, list.values())
+	list.Append(8)
+	assert.Equal(t, []int{7, 8}, list.values())
+}
+
+func TestLinkedList_YAML(t *testing.T) {
+	var config = codecConfig{Steps: NewSinglyLinkedList[string](), History: NewDoublyLinkedList[int]()}
+	config.History.Append(1, 2)
+	data, err := yaml.Marshal(config)
+	assert.Nil(t, err)
+	assert.Equal(t, "steps: []\nhistory:\n    - 1\n    - 2\n", string(data))
+
+	var decoded codecConfig
+	assert.Nil(t, yaml.Unmarshal([]byte("steps: [a]\nhistory: [5, 6]\n"), &decoded))
+	assert.Equal(t, []string{"a"}, decoded.Steps.values())
+	assert.Equal(t, []int{5, 6}, decoded.History.values())
+}
+
+func TestLinkedList_Text(t *testing.T) {
+	var list = NewDoublyLinkedList[int]()
+	list.Append(1, 2)
+	text, err := list.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, `[1,2]`, string(text))
+
+	var decoded SinglyLinkedList[int]
+	assert.Nil(t, decoded.UnmarshalText(text))
+	assert.Equal(t, []int{1, 2}, decoded.values())
+}
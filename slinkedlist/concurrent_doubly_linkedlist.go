@@ -0,0 +1,215 @@
+package slinkedlist
+
+import "sync"
+
+// ConcurrentDoublyLinkedList is a DoublyLinkedList that is safe for concurrent use by multiple goroutines.
+// Reads share a sync.RWMutex, while writes hold it exclusively.
+//
+// The callbacks of Walk and Iterate run on a snapshot without holding the lock, so they can
+// call other methods of the list. The callback of Compute runs under the write lock,
+// so it must not call methods of the same list.
+type ConcurrentDoublyLinkedList[T comparable] struct {
+	mu   sync.RWMutex
+	list *DoublyLinkedList[T]
+}
+
+// NewConcurrentDoublyLinkedList creates a new concurrent doubly linked list.
+func NewConcurrentDoublyLinkedList[T comparable]() *ConcurrentDoublyLinkedList[T] {
+	return &ConcurrentDoublyLinkedList[T]{list: NewDoublyLinkedList[T]()}
+}
+
+// Append adds one or more values to the end of the linked list.
+func (c *ConcurrentDoublyLinkedList[T]) Append(val ...T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Append(val...)
+}
+
+// InsertBefore inserts a new node with the specified value before the node at the specified index.
+func (c *ConcurrentDoublyLinkedList[T]) InsertBefore(index int, val T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.InsertBefore(index, val)
+}
+
+// InsertAfter inserts a new node with the specified value after the node at the specified index.
+func (c *ConcurrentDoublyLinkedList[T]) InsertAfter(index int, val T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.InsertAfter(index, val)
+}
+
+// Remove removes the node at the specified index.
+func (c *ConcurrentDoublyLinkedList[T]) Remove(index int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Remove(index)
+}
+
+// IndexOf returns the index of the first occurrence of the specified value in the linked list.
+func (c *ConcurrentDoublyLinkedList[T]) IndexOf(val T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.IndexOf(val)
+}
+
+// Find returns the node at the specified index.
+// The node is shared with the list, so it must not be used while the list is being modified.
+func (c *ConcurrentDoublyLinkedList[T]) Find(index int) *DoublyNode[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Find(index)
+}
+
+// Update updates the value of the node at the specified index.
+func (c *ConcurrentDoublyLinkedList[T]) Update(index int, newVal T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Update(index, newVal)
+}
+
+// Walk applies a function to each value in a snapshot of the linked list, without holding the lock.
+func (c *ConcurrentDoublyLinkedList[T]) Walk(f func(T), reverse ...bool) {
+	c.Snapshot().Walk(f, reverse...)
+}
+
+// Iterate calls f sequentially for each value in a snapshot of the linked list from head to tail,
+// without holding the lock. If f returns false, Iterate stops the iteration.
+func (c *ConcurrentDoublyLinkedList[T]) Iterate(f func(T) bool) {
+	c.Snapshot().Iterate(f)
+}
+
+// Reverse reverses the linked list.
+func (c *ConcurrentDoublyLinkedList[T]) Reverse() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Reverse()
+}
+
+// Merge appends a copy of the values of other to the linked list.
+// Unlike DoublyLinkedList.Merge, the nodes of other are not shared, so both lists stay independent.
+func (c *ConcurrentDoublyLinkedList[T]) Merge(other *ConcurrentDoublyLinkedList[T]) {
+	if other == nil {
+		return
+	}
+	var values = other.ToSlice()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Append(values...)
+}
+
+// Sort sorts the linked list by less, like DoublyLinkedList.Sort.
+func (c *ConcurrentDoublyLinkedList[T]) Sort(less func(a, b T) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Sort(less)
+}
+
+// SortStable sorts the linked list by less, keeping the original order of equal values.
+func (c *ConcurrentDoublyLinkedList[T]) SortStable(less func(a, b T) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.SortStable(less)
+}
+
+// MergeSorted merges a copy of the values of other into the linked list, when both are sorted by less,
+// so that the linked list stays sorted. Unlike DoublyLinkedList.MergeSorted, other is not modified.
+func (c *ConcurrentDoublyLinkedList[T]) MergeSorted(other *ConcurrentDoublyLinkedList[T], less func(a, b T) bool) {
+	if other == nil || other == c {
+		return
+	}
+	var snapshot = other.Snapshot()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.MergeSorted(snapshot, less)
+}
+
+// ToSlice converts the linked list to a slice.
+func (c *ConcurrentDoublyLinkedList[T]) ToSlice() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.ToSlice()
+}
+
+// Length returns the length of the linked list.
+func (c *ConcurrentDoublyLinkedList[T]) Length() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Length()
+}
+
+// IsEmpty checks if the linked list is empty.
+func (c *ConcurrentDoublyLinkedList[T]) IsEmpty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.IsEmpty()
+}
+
+// String returns a string representation of the linked list.
+func (c *ConcurrentDoublyLinkedList[T]) String() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.String()
+}
+
+// CountOf counts occurrences of a specific value in the linked list.
+func (c *ConcurrentDoublyLinkedList[T]) CountOf(val T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.CountOf(val)
+}
+
+// AppendIfAbsent appends val to the linked list atomically if it is not present.
+// It returns true if val has been appended.
+func (c *ConcurrentDoublyLinkedList[T]) AppendIfAbsent(val T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.list.IndexOf(val) >= 0 {
+		return false
+	}
+	c.list.Append(val)
+	return true
+}
+
+// PopLeftValue removes the head node atomically, and returns its value.
+// The bool is false if the linked list is empty.
+func (c *ConcurrentDoublyLinkedList[T]) PopLeftValue() (val T, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.list.head == nil {
+		return
+	}
+	val = c.list.head.val
+	c.list.Remove(0)
+	return val, true
+}
+
+// PopRightValue removes the tail node atomically, and returns its value.
+// The bool is false if the linked list is empty.
+func (c *ConcurrentDoublyLinkedList[T]) PopRightValue() (val T, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.list.tail == nil {
+		return
+	}
+	val = c.list.tail.val
+	c.list.unlink(c.list.tail)
+	return val, true
+}
+
+// Compute calls f with the underlying linked list under the write lock, so that a compound operation
+// runs atomically. The list must not be retained after f returns.
+func (c *ConcurrentDoublyLinkedList[T]) Compute(f func(list *DoublyLinkedList[T])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f(c.list)
+}
+
+// Snapshot returns a copy of the linked list as a plain DoublyLinkedList.
+func (c *ConcurrentDoublyLinkedList[T]) Snapshot() *DoublyLinkedList[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var snapshot = NewDoublyLinkedList[T]()
+	snapshot.Append(c.list.ToSlice()...)
+	return snapshot
+}
@@ -0,0 +1,116 @@
+package slinkedlist
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentSinglyLinkedList_ConcurrentAppendAndPop tests concurrent Append and PopLeftValue.
+func TestConcurrentSinglyLinkedList_ConcurrentAppendAndPop(t *testing.T) {
+	list := NewConcurrentSinglyLinkedList[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				list.Append(i)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2000, list.Length())
+
+	var mu sync.Mutex
+	var popped int
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if _, ok := list.PopLeftValue(); !ok {
+					return
+				}
+				mu.Lock()
+				popped++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2000, popped)
+	assert.True(t, list.IsEmpty())
+}
+
+// TestConcurrentDoublyLinkedList_PopValues tests PopLeftValue and PopRightValue.
+func TestConcurrentDoublyLinkedList_PopValues(t *testing.T) {
+	list := NewConcurrentDoublyLinkedList[int]()
+	list.Append(1, 2, 3)
+	v, ok := list.PopRightValue()
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	v, _ = list.PopLeftValue()
+	assert.Equal(t, 1, v)
+	v, _ = list.PopRightValue()
+	assert.Equal(t, 2, v)
+	_, ok = list.PopRightValue()
+	assert.False(t, ok)
+	_, ok = list.PopLeftValue()
+	assert.False(t, ok)
+	list.Append(4)
+	assert.Equal(t, "(int)(4)", list.String())
+}
+
+// TestConcurrentDoublyLinkedList_AppendIfAbsent tests that only one goroutine appends a value.
+func TestConcurrentDoublyLinkedList_AppendIfAbsent(t *testing.T) {
+	list := NewConcurrentDoublyLinkedList[string]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			list.AppendIfAbsent("a")
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, []string{"a"}, list.ToSlice())
+}
+
+// TestConcurrentLinkedList_Walk_CallbackCanModifyList tests that Walk runs on a snapshot.
+func TestConcurrentLinkedList_Walk_CallbackCanModifyList(t *testing.T) {
+	list := NewConcurrentDoublyLinkedList[int]()
+	list.Append(1, 2)
+	var backward []int
+	list.Walk(func(val int) {
+		list.Append(val * 10)
+		backward = append(backward, val)
+	}, true)
+	assert.Equal(t, []int{2, 1}, backward)
+	assert.Equal(t, []int{1, 2, 20, 10}, list.ToSlice())
+
+	singly := NewConcurrentSinglyLinkedList[int]()
+	singly.Append(1, 2)
+	singly.Iterate(func(val int) bool {
+		singly.Remove(0)
+		return true
+	})
+	assert.True(t, singly.IsEmpty())
+}
+
+// TestConcurrentLinkedList_Merge_CopiesValues tests that Merge does not share nodes between lists.
+func TestConcurrentLinkedList_Merge_CopiesValues(t *testing.T) {
+	a := NewConcurrentSinglyLinkedList[int]()
+	b := NewConcurrentSinglyLinkedList[int]()
+	a.Append(1)
+	b.Append(2)
+	a.Merge(b)
+	b.Append(3)
+	assert.Equal(t, []int{1, 2}, a.ToSlice())
+	assert.Equal(t, []int{2, 3}, b.ToSlice())
+
+	a.Compute(func(list *SinglyLinkedList[int]) {
+		list.Reverse()
+	})
+	assert.Equal(t, []int{2, 1}, a.ToSlice())
+}
@@ -0,0 +1,202 @@
+package slinkedlist
+
+import "sync"
+
+// ConcurrentSinglyLinkedList is a SinglyLinkedList that is safe for concurrent use by multiple goroutines.
+// Reads share a sync.RWMutex, while writes hold it exclusively.
+//
+// The callbacks of Walk and Iterate run on a snapshot without holding the lock, so they can
+// call other methods of the list. The callback of Compute runs under the write lock,
+// so it must not call methods of the same list.
+type ConcurrentSinglyLinkedList[T comparable] struct {
+	mu   sync.RWMutex
+	list *SinglyLinkedList[T]
+}
+
+// NewConcurrentSinglyLinkedList creates a new concurrent singly linked list.
+func NewConcurrentSinglyLinkedList[T comparable]() *ConcurrentSinglyLinkedList[T] {
+	return &ConcurrentSinglyLinkedList[T]{list: NewSinglyLinkedList[T]()}
+}
+
+// Append adds one or more values to the end of the linked list.
+func (c *ConcurrentSinglyLinkedList[T]) Append(val ...T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Append(val...)
+}
+
+// InsertBefore inserts a new node with the specified value before the node at the specified index.
+func (c *ConcurrentSinglyLinkedList[T]) InsertBefore(index int, val T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.InsertBefore(index, val)
+}
+
+// InsertAfter inserts a new node with the specified value after the node at the specified index.
+func (c *ConcurrentSinglyLinkedList[T]) InsertAfter(index int, val T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.InsertAfter(index, val)
+}
+
+// Remove removes the node at the specified index.
+func (c *ConcurrentSinglyLinkedList[T]) Remove(index int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Remove(index)
+}
+
+// IndexOf returns the index of the first occurrence of the specified value in the linked list.
+func (c *ConcurrentSinglyLinkedList[T]) IndexOf(val T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.IndexOf(val)
+}
+
+// Find returns the node at the specified index.
+// The node is shared with the list, so it must not be used while the list is being modified.
+func (c *ConcurrentSinglyLinkedList[T]) Find(index int) *SinglyNode[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Find(index)
+}
+
+// Update updates the value of the node at the specified index.
+func (c *ConcurrentSinglyLinkedList[T]) Update(index int, newVal T) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Update(index, newVal)
+}
+
+// Walk applies a function to each value in a snapshot of the linked list, without holding the lock.
+func (c *ConcurrentSinglyLinkedList[T]) Walk(f func(T)) {
+	c.Snapshot().Walk(f)
+}
+
+// Iterate calls f sequentially for each value in a snapshot of the linked list from head to tail,
+// without holding the lock. If f returns false, Iterate stops the iteration.
+func (c *ConcurrentSinglyLinkedList[T]) Iterate(f func(T) bool) {
+	c.Snapshot().Iterate(f)
+}
+
+// Reverse reverses the linked list.
+func (c *ConcurrentSinglyLinkedList[T]) Reverse() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Reverse()
+}
+
+// Merge appends a copy of the values of other to the linked list.
+// Unlike SinglyLinkedList.Merge, the nodes of other are not shared, so both lists stay independent.
+func (c *ConcurrentSinglyLinkedList[T]) Merge(other *ConcurrentSinglyLinkedList[T]) {
+	if other == nil {
+		return
+	}
+	var values = other.ToSlice()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Append(values...)
+}
+
+// Sort sorts the linked list by less, like SinglyLinkedList.Sort.
+func (c *ConcurrentSinglyLinkedList[T]) Sort(less func(a, b T) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.Sort(less)
+}
+
+// SortStable sorts the linked list by less, keeping the original order of equal values.
+func (c *ConcurrentSinglyLinkedList[T]) SortStable(less func(a, b T) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.SortStable(less)
+}
+
+// MergeSorted merges a copy of the values of other into the linked list, when both are sorted by less,
+// so that the linked list stays sorted. Unlike SinglyLinkedList.MergeSorted, other is not modified.
+func (c *ConcurrentSinglyLinkedList[T]) MergeSorted(other *ConcurrentSinglyLinkedList[T], less func(a, b T) bool) {
+	if other == nil || other == c {
+		return
+	}
+	var snapshot = other.Snapshot()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list.MergeSorted(snapshot, less)
+}
+
+// ToSlice converts the linked list to a slice.
+func (c *ConcurrentSinglyLinkedList[T]) ToSlice() []T {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.ToSlice()
+}
+
+// Length returns the length of the linked list.
+func (c *ConcurrentSinglyLinkedList[T]) Length() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Length()
+}
+
+// IsEmpty checks if the linked list is empty.
+func (c *ConcurrentSinglyLinkedList[T]) IsEmpty() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.IsEmpty()
+}
+
+// String returns a string representation of the linked list.
+func (c *ConcurrentSinglyLinkedList[T]) String() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.String()
+}
+
+// CountOf counts occurrences of a specific value in the linked list.
+func (c *ConcurrentSinglyLinkedList[T]) CountOf(val T) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.CountOf(val)
+}
+
+// AppendIfAbsent appends val to the linked list atomically if it is not present.
+// It returns true if val has been appended.
+func (c *ConcurrentSinglyLinkedList[T]) AppendIfAbsent(val T) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.list.IndexOf(val) >= 0 {
+		return false
+	}
+	c.list.Append(val)
+	return true
+}
+
+// PopLeftValue removes the head node atomically, and returns its value.
+// The bool is false if the linked list is empty.
+func (c *ConcurrentSinglyLinkedList[T]) PopLeftValue() (val T, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.list.head == nil {
+		return
+	}
+	val = c.list.head.val
+	c.list.Remove(0)
+	return val, true
+}
+
+// Compute calls f with the underlying linked list under the write lock, so that a compound operation
+// runs atomically. The list must not be retained after f returns.
+func (c *ConcurrentSinglyLinkedList[T]) Compute(f func(list *SinglyLinkedList[T])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	f(c.list)
+}
+
+// Snapshot returns a copy of the linked list as a plain SinglyLinkedList.
+func (c *ConcurrentSinglyLinkedList[T]) Snapshot() *SinglyLinkedList[T] {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var snapshot = NewSinglyLinkedList[T]()
+	snapshot.Append(c.list.ToSlice()...)
+	return snapshot
+}
@@ -0,0 +1,300 @@
+package slinkedlist
+
+import "errors"
+
+var (
+	// ErrConcurrentModification is returned by a cursor when its linked list has been structurally modified
+	// by something other than the cursor itself, e.g. another cursor or a method of the list.
+	ErrConcurrentModification = errors.New("linked list has been modified outside the cursor")
+	// ErrNoCurrentNode is returned by a cursor operation that needs a current node, when the cursor is
+	// not at a node, e.g. before the first call to Next or after Remove.
+	ErrNoCurrentNode = errors.New("cursor is not at a node")
+)
+
+// SinglyCursor is a forward cursor over SinglyLinkedList. It is either at a node, or in the gap between
+// two nodes (or before the head, or after the tail), e.g. after Remove.
+//
+// The cursor stays valid across its own mutations. If the list is structurally modified in any other way,
+// the cursor fails fast: Next returns false and Err, Set, InsertBefore, InsertAfter and Remove
+// return ErrConcurrentModification.
+type SinglyCursor[T comparable] struct {
+	list *SinglyLinkedList[T]
+	node *SinglyNode[T] // the current node, nil if the cursor is in a gap
+	prev *SinglyNode[T] // the node before the current node or the gap, nil at the head
+	next *SinglyNode[T] // the node after the gap, only used when node is nil
+	mods int
+	err  error
+}
+
+// Cursor returns a cursor positioned before the head of the linked list. Call Next to move it to the head:
+//
+//	for c := list.Cursor(); c.Next(); {
+//		fmt.Println(c.Value())
+//	}
+func (list *SinglyLinkedList[T]) Cursor() *SinglyCursor[T] {
+	return &SinglyCursor[T]{list: list, next: list.head, mods: list.mods}
+}
+
+// Next moves the cursor to the next node. It returns false if there is no next node, in which case
+// the cursor is after the tail, or if the list has been modified outside the cursor.
+func (c *SinglyCursor[T]) Next() bool {
+	if !c.check() {
+		return false
+	}
+	if c.node == nil {
+		if c.next == nil {
+			return false
+		}
+		c.node, c.next = c.next, nil
+		return true
+	}
+	if c.node.next == nil {
+		c.prev, c.node = c.node, nil
+		return false
+	}
+	c.prev, c.node = c.node, c.node.next
+	return true
+}
+
+// Value returns the value of the current node, or the zero value if the cursor is not at a node.
+func (c *SinglyCursor[T]) Value() (val T) {
+	if c.node == nil {
+		return
+	}
+	return c.node.val
+}
+
+// Set sets the value of the current node.
+func (c *SinglyCursor[T]) Set(val T) error {
+	if err := c.checkNode(); err != nil {
+		return err
+	}
+	c.node.val = val
+	return nil
+}
+
+// InsertBefore inserts a new node with the specified value before the current node, or into the gap
+// if the cursor is not at a node. The cursor stays where it is, so the new node is not visited by Next.
+func (c *SinglyCursor[T]) InsertBefore(val T) error {
+	if !c.check() {
+		return c.err
+	}
+	c.prev = c.list.insertAfterNode(c.prev, val)
+	c.mods = c.list.mods
+	return nil
+}
+
+// InsertAfter inserts a new node with the specified value after the current node, or into the gap
+// if the cursor is not at a node. The cursor stays where it is, so the new node is the next one visited by Next.
+func (c *SinglyCursor[T]) InsertAfter(val T) error {
+	if !c.check() {
+		return c.err
+	}
+	if c.node != nil {
+		c.list.insertAfterNode(c.node, val)
+	} else {
+		c.next = c.list.insertAfterNode(c.prev, val)
+	}
+	c.mods = c.list.mods
+	return nil
+}
+
+// Remove removes the current node. The cursor is left in the gap where the node was,
+// so Next moves to the node that followed it.
+func (c *SinglyCursor[T]) Remove() error {
+	if err := c.checkNode(); err != nil {
+		return err
+	}
+	c.list.removeAfterNode(c.prev)
+	c.node, c.next = nil, c.node.next
+	c.mods = c.list.mods
+	return nil
+}
+
+// Err returns ErrConcurrentModification if the cursor has detected a modification outside the cursor,
+// or nil otherwise.
+func (c *SinglyCursor[T]) Err() error {
+	return c.err
+}
+
+func (c *SinglyCursor[T]) check() bool {
+	if c.err == nil && c.mods != c.list.mods {
+		c.err = ErrConcurrentModification
+	}
+	return c.err == nil
+}
+
+func (c *SinglyCursor[T]) checkNode() error {
+	if !c.check() {
+		return c.err
+	}
+	if c.node == nil {
+		return ErrNoCurrentNode
+	}
+	return nil
+}
+
+// DoublyCursor is a bidirectional cursor over DoublyLinkedList. It is either at a node, or in the gap
+// between two nodes (or before the head, or after the tail), e.g. after Remove.
+//
+// The cursor stays valid across its own mutations. If the list is structurally modified in any other way,
+// the cursor fails fast: Next and Prev return false and Err, Set, InsertBefore, InsertAfter and Remove
+// return ErrConcurrentModification.
+type DoublyCursor[T comparable] struct {
+	list       *DoublyLinkedList[T]
+	node       *DoublyNode[T] // the current node, nil if the cursor is in a gap
+	prev, next *DoublyNode[T] // the nodes around the gap, only used when node is nil
+	mods       int
+	err        error
+}
+
+// Cursor returns a cursor positioned before the head of the linked list. Call Next to move it to the head.
+func (list *DoublyLinkedList[T]) Cursor() *DoublyCursor[T] {
+	return &DoublyCursor[T]{list: list, next: list.head, mods: list.mods}
+}
+
+// CursorFromBack returns a cursor positioned after the tail of the linked list. Call Prev to move it to the tail:
+//
+//	for c := list.CursorFromBack(); c.Prev(); {
+//		fmt.Println(c.Value())
+//	}
+func (list *DoublyLinkedList[T]) CursorFromBack() *DoublyCursor[T] {
+	return &DoublyCursor[T]{list: list, prev: list.tail, mods: list.mods}
+}
+
+// Next moves the cursor to the next node. It returns false if there is no next node, in which case
+// the cursor is after the tail, or if the list has been modified outside the cursor.
+func (c *DoublyCursor[T]) Next() bool {
+	if !c.check() {
+		return false
+	}
+	if c.node == nil {
+		if c.next == nil {
+			return false
+		}
+		c.node, c.prev, c.next = c.next, nil, nil
+		return true
+	}
+	if c.node.next == nil {
+		c.node, c.prev = nil, c.node
+		return false
+	}
+	c.node = c.node.next
+	return true
+}
+
+// Prev moves the cursor to the previous node. It returns false if there is no previous node, in which case
+// the cursor is before the head, or if the list has been modified outside the cursor.
+func (c *DoublyCursor[T]) Prev() bool {
+	if !c.check() {
+		return false
+	}
+	if c.node == nil {
+		if c.prev == nil {
+			return false
+		}
+		c.node, c.prev, c.next = c.prev, nil, nil
+		return true
+	}
+	if c.node.prev == nil {
+		c.node, c.next = nil, c.node
+		return false
+	}
+	c.node = c.node.prev
+	return true
+}
+
+// Value returns the value of the current node, or the zero value if the cursor is not at a node.
+func (c *DoublyCursor[T]) Value() (val T) {
+	if c.node == nil {
+		return
+	}
+	return c.node.val
+}
+
+// Node returns the current node, or nil if the cursor is not at a node.
+func (c *DoublyCursor[T]) Node() *DoublyNode[T] {
+	return c.node
+}
+
+// Set sets the value of the current node.
+func (c *DoublyCursor[T]) Set(val T) error {
+	if err := c.checkNode(); err != nil {
+		return err
+	}
+	c.node.val = val
+	return nil
+}
+
+// InsertBefore inserts a new node with the specified value before the current node, or into the gap
+// if the cursor is not at a node. The cursor stays where it is, so the new node is the next one visited by Prev.
+func (c *DoublyCursor[T]) InsertBefore(val T) error {
+	if !c.check() {
+		return c.err
+	}
+	if c.node != nil {
+		c.list.InsertBeforeNode(c.node, val)
+	} else {
+		c.prev = c.insertIntoGap(val)
+	}
+	c.mods = c.list.mods
+	return nil
+}
+
+// InsertAfter inserts a new node with the specified value after the current node, or into the gap
+// if the cursor is not at a node. The cursor stays where it is, so the new node is the next one visited by Next.
+func (c *DoublyCursor[T]) InsertAfter(val T) error {
+	if !c.check() {
+		return c.err
+	}
+	if c.node != nil {
+		c.list.InsertAfterNode(c.node, val)
+	} else {
+		c.next = c.insertIntoGap(val)
+	}
+	c.mods = c.list.mods
+	return nil
+}
+
+// Remove removes the current node. The cursor is left in the gap where the node was,
+// so Next and Prev move to the nodes around it.
+func (c *DoublyCursor[T]) Remove() error {
+	if err := c.checkNode(); err != nil {
+		return err
+	}
+	c.prev, c.next = c.node.prev, c.node.next
+	c.list.unlink(c.node)
+	c.node = nil
+	c.mods = c.list.mods
+	return nil
+}
+
+// Err returns ErrConcurrentModification if the cursor has detected a modification outside the cursor,
+// or nil otherwise.
+func (c *DoublyCursor[T]) Err() error {
+	return c.err
+}
+
+func (c *DoublyCursor[T]) insertIntoGap(val T) *DoublyNode[T] {
+	if c.prev != nil {
+		return c.list.InsertAfterNode(c.prev, val)
+	}
+	return c.list.PushFront(val)
+}
+
+func (c *DoublyCursor[T]) check() bool {
+	if c.err == nil && c.mods != c.list.mods {
+		c.err = ErrConcurrentModification
+	}
+	return c.err == nil
+}
+
+func (c *DoublyCursor[T]) checkNode() error {
+	if !c.check() {
+		return c.err
+	}
+	if c.node == nil {
+		return ErrNoCurrentNode
+	}
+	return nil
+}
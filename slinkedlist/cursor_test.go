@@ -0,0 +1,293 @@
+package slinkedlist
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+func newSingly(vals ...int) *SinglyLinkedList[int] {
+	list := NewSinglyLinkedList[int]()
+	list.Append(vals...)
+	return list
+}
+
+func newDoubly(vals ...int) *DoublyLinkedList[int] {
+	list := NewDoublyLinkedList[int]()
+	list.Append(vals...)
+	return list
+}
+
+// checkTail checks that the tail of the singly linked list is its last node.
+func checkTail[T comparable](t *testing.T, list *SinglyLinkedList[T]) {
+	t.Helper()
+	var last *SinglyNode[T]
+	for node := list.head; node != nil; node = node.next {
+		last = node
+	}
+	assert.Equal(t, last, list.tail)
+}
+
+func TestSinglyCursor_Next(t *testing.T) {
+	var got []int
+	for c := newSingly(1, 2, 3).Cursor(); c.Next(); {
+		got = append(got, c.Value())
+	}
+	assert.Equal(t, []int{1, 2, 3}, got)
+
+	c := newSingly().Cursor()
+	assert.False(t, c.Next())
+	assert.Equal(t, 0, c.Value())
+	assert.Nil(t, c.Err())
+
+	// stop early and resume
+	c = newSingly(1, 2, 3).Cursor()
+	assert.True(t, c.Next())
+	assert.Equal(t, 1, c.Value())
+	assert.True(t, c.Next())
+	assert.Equal(t, 2, c.Value())
+	assert.True(t, c.Next())
+	assert.False(t, c.Next())
+	assert.False(t, c.Next())
+	assert.Equal(t, 0, c.Value())
+}
+
+func TestSinglyCursor_Mutations(t *testing.T) {
+	list := newSingly(1, 2, 3, 4, 5)
+	c := list.Cursor()
+	assert.Equal(t, ErrNoCurrentNode, c.Set(0))
+	assert.Equal(t, ErrNoCurrentNode, c.Remove())
+
+	for c.Next() {
+		switch c.Value() {
+		case 1:
+			assert.Nil(t, c.InsertBefore(0))
+		case 2:
+			assert.Nil(t, c.Remove())
+			assert.Equal(t, ErrNoCurrentNode, c.Remove())
+		case 3:
+			assert.Nil(t, c.Set(30))
+			assert.Nil(t, c.InsertAfter(35))
+		case 5:
+			assert.Nil(t, c.Remove())
+		}
+	}
+	assert.Nil(t, c.Err())
+	assert.Equal(t, []int{0, 1, 30, 35, 4}, list.ToSlice())
+	checkTail(t, list)
+
+	// the cursor is after the tail now
+	assert.Nil(t, c.InsertBefore(6))
+	assert.Nil(t, c.InsertAfter(7))
+	assert.True(t, c.Next())
+	assert.Equal(t, 7, c.Value())
+	assert.Equal(t, []int{0, 1, 30, 35, 4, 6, 7}, list.ToSlice())
+	checkTail(t, list)
+
+	// remove all the nodes
+	for c = list.Cursor(); c.Next(); {
+		assert.Nil(t, c.Remove())
+	}
+	assert.True(t, list.IsEmpty())
+	checkTail(t, list)
+
+	// insert into an empty list
+	assert.Nil(t, c.InsertAfter(2))
+	assert.Nil(t, c.InsertBefore(1))
+	assert.Equal(t, []int{1, 2}, list.ToSlice())
+	checkTail(t, list)
+}
+
+func TestSinglyCursor_ConcurrentModification(t *testing.T) {
+	list := newSingly(1, 2, 3)
+	c := list.Cursor()
+	assert.True(t, c.Next())
+	list.Append(4)
+	assert.False(t, c.Next())
+	assert.Equal(t, ErrConcurrentModification, c.Err())
+	assert.Equal(t, ErrConcurrentModification, c.Set(0))
+	assert.Equal(t, ErrConcurrentModification, c.InsertAfter(0))
+	assert.Equal(t, ErrConcurrentModification, c.Remove())
+	assert.Equal(t, []int{1, 2, 3, 4}, list.ToSlice())
+
+	// Update is not a structural modification
+	c = list.Cursor()
+	assert.True(t, c.Next())
+	assert.Nil(t, list.Update(1, 20))
+	assert.True(t, c.Next())
+	assert.Equal(t, 20, c.Value())
+
+	// another cursor
+	c1, c2 := list.Cursor(), list.Cursor()
+	assert.True(t, c1.Next())
+	assert.True(t, c2.Next())
+	assert.Nil(t, c1.Remove())
+	assert.False(t, c2.Next())
+	assert.Equal(t, ErrConcurrentModification, c2.Err())
+	assert.True(t, c1.Next())
+}
+
+func TestDoublyCursor_NextPrev(t *testing.T) {
+	list := newDoubly(1, 2, 3)
+	var got []int
+	for c := list.Cursor(); c.Next(); {
+		got = append(got, c.Value())
+	}
+	assert.Equal(t, []int{1, 2, 3}, got)
+
+	got = nil
+	for c := list.CursorFromBack(); c.Prev(); {
+		got = append(got, c.Value())
+	}
+	assert.Equal(t, []int{3, 2, 1}, got)
+
+	c := list.Cursor()
+	assert.False(t, c.Prev())
+	assert.True(t, c.Next())
+	assert.True(t, c.Next())
+	assert.Equal(t, 2, c.Value())
+	assert.Equal(t, list.Find(1), c.Node())
+	assert.True(t, c.Prev())
+	assert.Equal(t, 1, c.Value())
+	assert.False(t, c.Prev())
+	assert.Nil(t, c.Node())
+	assert.True(t, c.Next())
+	assert.Equal(t, 1, c.Value())
+
+	c = newDoubly().CursorFromBack()
+	assert.False(t, c.Prev())
+	assert.False(t, c.Next())
+	assert.Nil(t, c.Err())
+}
+
+func TestDoublyCursor_Mutations(t *testing.T) {
+	list := newDoubly(1, 2, 3, 4, 5)
+	c := list.Cursor()
+	assert.Equal(t, ErrNoCurrentNode, c.Set(0))
+	assert.Equal(t, ErrNoCurrentNode, c.Remove())
+
+	for c.Next() {
+		switch c.Value() {
+		case 1:
+			assert.Nil(t, c.InsertBefore(0))
+		case 2:
+			assert.Nil(t, c.Remove())
+		case 3:
+			assert.Nil(t, c.Set(30))
+			assert.Nil(t, c.InsertAfter(35))
+		case 5:
+			assert.Nil(t, c.Remove())
+		}
+	}
+	assert.Nil(t, c.Err())
+	assert.Equal(t, []int{0, 1, 30, 35, 4}, list.ToSlice())
+	checkLinks(t, list)
+
+	// walk backward and remove from the gap left by Remove
+	c = list.CursorFromBack()
+	assert.True(t, c.Prev())
+	assert.True(t, c.Prev())
+	assert.Equal(t, 35, c.Value())
+	assert.Nil(t, c.Remove())
+	assert.Nil(t, c.InsertBefore(31))
+	assert.Nil(t, c.InsertAfter(34))
+	assert.True(t, c.Prev())
+	assert.Equal(t, 31, c.Value())
+	assert.True(t, c.Next())
+	assert.Equal(t, 34, c.Value())
+	assert.Equal(t, []int{0, 1, 30, 31, 34, 4}, list.ToSlice())
+	checkLinks(t, list)
+
+	// remove all the nodes backward
+	for c = list.CursorFromBack(); c.Prev(); {
+		assert.Nil(t, c.Remove())
+	}
+	assert.True(t, list.IsEmpty())
+	checkLinks(t, list)
+
+	assert.Nil(t, c.InsertBefore(1))
+	assert.Nil(t, c.InsertAfter(2))
+	assert.Equal(t, []int{1, 2}, list.ToSlice())
+	checkLinks(t, list)
+}
+
+func TestDoublyCursor_ConcurrentModification(t *testing.T) {
+	list := newDoubly(1, 2, 3)
+	modify := []func(){
+		func() { list.PushBack(4) },
+		func() { list.MoveToFront(list.Back()) },
+		func() { list.Reverse() },
+		func() { list.Remove(0) },
+		func() { list.Merge(newDoubly(5)) },
+	}
+	for _, f := range modify {
+		c := list.Cursor()
+		assert.True(t, c.Next())
+		f()
+		assert.False(t, c.Prev())
+		assert.Equal(t, ErrConcurrentModification, c.Err())
+		assert.Equal(t, ErrConcurrentModification, c.InsertBefore(0))
+		assert.Equal(t, ErrConcurrentModification, c.Remove())
+	}
+
+	other := newDoubly(1)
+	c := other.Cursor()
+	list.Merge(other)
+	assert.False(t, c.Next())
+	assert.Equal(t, ErrConcurrentModification, c.Err())
+}
+
+// TestCursor_Random compares random cursor operations on both linked lists with a slice.
+func TestCursor_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	singly, doubly := newSingly(), newDoubly()
+	var want []int
+	sc, dc := singly.Cursor(), doubly.Cursor()
+	// pos is the index of the current node, or of the node after the gap; onNode tells which one
+	pos, onNode := 0, false
+	for i := 0; i < 2000; i++ {
+		switch op := r.Intn(5); {
+		case op == 0:
+			ok := pos+1 < len(want) || (!onNode && pos < len(want))
+			assert.Equal(t, ok, sc.Next())
+			assert.Equal(t, ok, dc.Next())
+			if ok {
+				if onNode {
+					pos++
+				}
+				onNode = true
+			} else if onNode {
+				pos, onNode = len(want), false
+			}
+		case op == 1 && onNode:
+			assert.Nil(t, sc.Remove())
+			assert.Nil(t, dc.Remove())
+			want = append(want[:pos], want[pos+1:]...)
+			onNode = false
+		case op == 2:
+			assert.Nil(t, sc.InsertBefore(i))
+			assert.Nil(t, dc.InsertBefore(i))
+			want = append(want[:pos], append([]int{i}, want[pos:]...)...)
+			pos++
+		case op == 3:
+			assert.Nil(t, sc.InsertAfter(i))
+			assert.Nil(t, dc.InsertAfter(i))
+			at := pos
+			if onNode {
+				at++
+			}
+			want = append(want[:at], append([]int{i}, want[at:]...)...)
+		case op == 4 && r.Intn(10) == 0:
+			sc, dc = singly.Cursor(), doubly.Cursor()
+			pos, onNode = 0, false
+		}
+		if onNode {
+			assert.Equal(t, want[pos], sc.Value())
+			assert.Equal(t, want[pos], dc.Value())
+		}
+	}
+	assert.Equal(t, want, singly.ToSlice())
+	assert.Equal(t, want, doubly.ToSlice())
+	checkTail(t, singly)
+	checkLinks(t, doubly)
+}
@@ -5,15 +5,39 @@ import (
 	"github.com/chaseSpace/bear/butil"
 )
 
+// DoublyNode is a node of DoublyLinkedList. It can be kept as a handle to its value, to move or remove it
+// in O(1) with the node-based methods of DoublyLinkedList.
 type DoublyNode[T comparable] struct {
 	val  T
 	prev *DoublyNode[T]
 	next *DoublyNode[T]
+	list *DoublyLinkedList[T] // the list that the node belongs to, nil once removed
+}
+
+// Value returns the value of the node.
+func (node *DoublyNode[T]) Value() T {
+	return node.val
+}
+
+// SetValue sets the value of the node.
+func (node *DoublyNode[T]) SetValue(val T) {
+	node.val = val
+}
+
+// Next returns the next node, or nil if the node is the tail or has been removed.
+func (node *DoublyNode[T]) Next() *DoublyNode[T] {
+	return node.next
+}
+
+// Prev returns the previous node, or nil if the node is the head or has been removed.
+func (node *DoublyNode[T]) Prev() *DoublyNode[T] {
+	return node.prev
 }
 
 type DoublyLinkedList[T comparable] struct {
 	head *DoublyNode[T]
 	tail *DoublyNode[T]
+	mods int // counts the structural modifications, so that cursors can detect them
 }
 
 // NewDoublyLinkedList creates a new doubly linked list.
@@ -26,10 +50,11 @@ func (list *DoublyLinkedList[T]) Append(val ...T) {
 	if len(val) == 0 {
 		return
 	}
-	curr := &DoublyNode[T]{val: val[0], prev: nil, next: nil}
+	list.mods++
+	curr := &DoublyNode[T]{val: val[0], prev: nil, next: nil, list: list}
 	first := curr
 	for i := 1; i < len(val); i++ {
-		curr.next = &DoublyNode[T]{val: val[i], prev: curr, next: nil}
+		curr.next = &DoublyNode[T]{val: val[i], prev: curr, next: nil, list: list}
 		curr = curr.next
 	}
 	if list.head == nil { // empty list
@@ -52,10 +77,12 @@ func (list *DoublyLinkedList[T]) InsertBefore(index int, val T) error {
 	if list.head == nil { // operation on the empty list is prohibited
 		return fmt.Errorf("index out of range")
 	}
-	newNode := &DoublyNode[T]{val: val, next: nil}
+	newNode := &DoublyNode[T]{val: val, next: nil, list: list}
 	if index == 0 { // insert before head
 		newNode.next = list.head
+		list.head.prev = newNode
 		list.head = newNode
+		list.mods++
 		return nil
 	}
 
@@ -71,10 +98,12 @@ func (list *DoublyLinkedList[T]) InsertBefore(index int, val T) error {
 	if current.next == nil { // index = length(point to tail)
 		return fmt.Errorf("index out of range")
 	}
-	// do connect current <-> newNode
+	// do connect current <-> newNode <-> current.next
 	newNode.prev = current
 	newNode.next = current.next
+	current.next.prev = newNode
 	current.next = newNode
+	list.mods++
 	// because insertion into(replace) the tail is not allowed here, there is no need to change the tail node
 	return nil
 }
@@ -87,7 +116,7 @@ func (list *DoublyLinkedList[T]) InsertAfter(index int, val T) error {
 	if list.head == nil { // operation on the empty list is prohibited
 		return fmt.Errorf("index out of range")
 	}
-	newNode := &DoublyNode[T]{val: val, next: nil}
+	newNode := &DoublyNode[T]{val: val, next: nil, list: list}
 
 	current := list.head
 	// find the node points to index
@@ -101,45 +130,21 @@ func (list *DoublyLinkedList[T]) InsertAfter(index int, val T) error {
 	if current.next == nil {
 		list.tail = newNode
 	}
-	// do connect current <-> newNode
+	// do connect current <-> newNode <-> current.next
 	newNode.prev = current
 	newNode.next = current.next
+	if current.next != nil {
+		current.next.prev = newNode
+	}
 	current.next = newNode
+	list.mods++
 	return nil
 }
 
 // Remove removes the node at the specified index.
 func (list *DoublyLinkedList[T]) Remove(index int) {
-	if list.head == nil || index < 0 {
-		return
-	}
-
-	if index == 0 {
-		list.head = list.head.next
-		if list.head != nil {
-			list.head.prev = nil
-		} else {
-			list.tail = nil
-		}
-		return
-	}
-	// find one this is before the node points to index
-	current := list.head
-	for i := 1; current != nil && i < index; i++ {
-		current = current.next
-	}
-
-	// if current is nil or current.next is nil, the index is out of range
-	if current == nil || current.next == nil {
-		return
-	}
-
-	// remove the node at index
-	current.next = current.next.next
-	if current.next != nil {
-		current.next.prev = current
-	} else {
-		list.tail = current
+	if node := list.Find(index); node != nil {
+		list.unlink(node)
 	}
 }
 
@@ -195,6 +200,16 @@ func (list *DoublyLinkedList[T]) Walk(f func(T), reverse ...bool) {
 	}
 }
 
+// Iterate calls f sequentially for each value in the linked list from head to tail.
+// If f returns false, Iterate stops the iteration.
+func (list *DoublyLinkedList[T]) Iterate(f func(T) bool) {
+	for current := list.head; current != nil; current = current.next {
+		if !f(current.val) {
+			return
+		}
+	}
+}
+
 // Reverse reverses the linked list.
 func (list *DoublyLinkedList[T]) Reverse() {
 	if list.head == nil || list.head.next == nil {
@@ -217,13 +232,20 @@ func (list *DoublyLinkedList[T]) Reverse() {
 	}
 	list.tail = list.head
 	list.head = curr
+	list.mods++
 }
 
 // Merge merges the current linked list with another linked list.
+// The nodes of other are moved to the list, so other should not be used afterwards.
 func (list *DoublyLinkedList[T]) Merge(other *DoublyLinkedList[T]) {
 	if other == nil || other.head == nil {
 		return
 	}
+	for node := other.head; node != nil; node = node.next {
+		node.list = list
+	}
+	list.mods++
+	other.mods++
 	if list.head == nil {
 		list.head = other.head
 		list.tail = other.tail
@@ -290,3 +312,148 @@ func (list *DoublyLinkedList[T]) CountOf(val T) int {
 	}
 	return count
 }
+
+// Front returns the head node, or nil if the linked list is empty.
+func (list *DoublyLinkedList[T]) Front() *DoublyNode[T] {
+	return list.head
+}
+
+// Back returns the tail node, or nil if the linked list is empty.
+func (list *DoublyLinkedList[T]) Back() *DoublyNode[T] {
+	return list.tail
+}
+
+// PushFront inserts a new node with the specified value at the head of the linked list, and returns it.
+func (list *DoublyLinkedList[T]) PushFront(val T) *DoublyNode[T] {
+	node := &DoublyNode[T]{val: val, list: list}
+	if list.head == nil {
+		list.head, list.tail = node, node
+		list.mods++
+		return node
+	}
+	list.linkBefore(node, list.head)
+	return node
+}
+
+// PushBack inserts a new node with the specified value at the tail of the linked list, and returns it.
+func (list *DoublyLinkedList[T]) PushBack(val T) *DoublyNode[T] {
+	node := &DoublyNode[T]{val: val, list: list}
+	if list.tail == nil {
+		list.head, list.tail = node, node
+		list.mods++
+		return node
+	}
+	list.linkAfter(node, list.tail)
+	return node
+}
+
+// InsertBeforeNode inserts a new node with the specified value before mark in O(1), and returns it.
+// It returns nil if mark is not a node of the linked list.
+func (list *DoublyLinkedList[T]) InsertBeforeNode(mark *DoublyNode[T], val T) *DoublyNode[T] {
+	if mark == nil || mark.list != list {
+		return nil
+	}
+	node := &DoublyNode[T]{val: val, list: list}
+	list.linkBefore(node, mark)
+	return node
+}
+
+// InsertAfterNode inserts a new node with the specified value after mark in O(1), and returns it.
+// It returns nil if mark is not a node of the linked list.
+func (list *DoublyLinkedList[T]) InsertAfterNode(mark *DoublyNode[T], val T) *DoublyNode[T] {
+	if mark == nil || mark.list != list {
+		return nil
+	}
+	node := &DoublyNode[T]{val: val, list: list}
+	list.linkAfter(node, mark)
+	return node
+}
+
+// RemoveNode removes node from the linked list in O(1).
+// It returns false if node is not a node of the linked list, e.g. it has already been removed.
+func (list *DoublyLinkedList[T]) RemoveNode(node *DoublyNode[T]) bool {
+	if node == nil || node.list != list {
+		return false
+	}
+	list.unlink(node)
+	return true
+}
+
+// MoveToFront moves node to the head of the linked list in O(1).
+// It returns false if node is not a node of the linked list.
+func (list *DoublyLinkedList[T]) MoveToFront(node *DoublyNode[T]) bool {
+	if node == nil || node.list != list {
+		return false
+	}
+	if node != list.head {
+		list.unlink(node)
+		node.list = list
+		list.linkBefore(node, list.head)
+	}
+	return true
+}
+
+// MoveToBack moves node to the tail of the linked list in O(1).
+// It returns false if node is not a node of the linked list.
+func (list *DoublyLinkedList[T]) MoveToBack(node *DoublyNode[T]) bool {
+	if node == nil || node.list != list {
+		return false
+	}
+	if node != list.tail {
+		list.unlink(node)
+		node.list = list
+		list.linkAfter(node, list.tail)
+	}
+	return true
+}
+
+// linkBefore links node before mark, which is in the linked list.
+func (list *DoublyLinkedList[T]) linkBefore(node, mark *DoublyNode[T]) {
+	node.prev, node.next = mark.prev, mark
+	if mark.prev != nil {
+		mark.prev.next = node
+	} else {
+		list.head = node
+	}
+	mark.prev = node
+	list.mods++
+}
+
+// linkAfter links node after mark, which is in the linked list.
+func (list *DoublyLinkedList[T]) linkAfter(node, mark *DoublyNode[T]) {
+	node.prev, node.next = mark, mark.next
+	if mark.next != nil {
+		mark.next.prev = node
+	} else {
+		list.tail = node
+	}
+	mark.next = node
+	list.mods++
+}
+
+// unlink removes node from the linked list, and detaches it so that it can no longer be used with the list.
+func (list *DoublyLinkedList[T]) unlink(node *DoublyNode[T]) {
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		list.head = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		list.tail = node.prev
+	}
+	node.prev, node.next, node.list = nil, nil, nil
+	list.mods++
+}
+
+// clear removes all the nodes from the linked list.
+func (list *DoublyLinkedList[T]) clear() {
+	for node := list.head; node != nil; {
+		next := node.next
+		node.prev, node.next, node.list = nil, nil, nil
+		node = next
+	}
+	list.head, list.tail = nil, nil
+	list.mods++
+}
@@ -662,3 +662,30 @@ func TestCountOf_MultipleNodesSomeMatching_ShouldReturnCount_Doubly(t *testing.T
 	list.Append(1)
 	assert.Equal(t, 3, list.CountOf(1))
 }
+
+// TestDoublyLinkedList_Iterate_StopsWhenFalseReturned tests the Iterate method stops when f returns false.
+func TestDoublyLinkedList_Iterate_StopsWhenFalseReturned(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Append(1, 2, 3)
+	var visited []int
+	list.Iterate(func(val int) bool {
+		visited = append(visited, val)
+		return val < 2
+	})
+	assert.Equal(t, []int{1, 2}, visited)
+}
+
+// TestInsert_KeepsPrevLinks tests that InsertBefore and InsertAfter keep the prev links consistent.
+func TestInsert_KeepsPrevLinks(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Append(2, 4)
+	assert.NoError(t, list.InsertBefore(0, 1))
+	assert.NoError(t, list.InsertBefore(2, 3))
+	assert.NoError(t, list.InsertAfter(0, 10))
+	var backward []int
+	list.Walk(func(val int) {
+		backward = append(backward, val)
+	}, true)
+	assert.Equal(t, []int{1, 10, 2, 3, 4}, list.ToSlice())
+	assert.Equal(t, []int{4, 3, 2, 10, 1}, backward)
+}
@@ -0,0 +1,170 @@
+package slinkedlist
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// checkLinks checks that the prev and next links and the owner of each node are consistent.
+func checkLinks[T comparable](t *testing.T, list *DoublyLinkedList[T]) {
+	t.Helper()
+	var prev *DoublyNode[T]
+	for node := list.Front(); node != nil; node = node.Next() {
+		assert.Equal(t, prev, node.Prev())
+		assert.Equal(t, list, node.list)
+		prev = node
+	}
+	assert.Equal(t, prev, list.Back())
+}
+
+func TestDoublyLinkedList_PushFrontBack(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	assert.Nil(t, list.Front())
+	assert.Nil(t, list.Back())
+
+	two := list.PushBack(2)
+	one := list.PushFront(1)
+	three := list.PushBack(3)
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.Equal(t, one, list.Front())
+	assert.Equal(t, three, list.Back())
+	assert.Equal(t, two, one.Next())
+	assert.Equal(t, two, three.Prev())
+	assert.Equal(t, 2, two.Value())
+	checkLinks(t, list)
+
+	two.SetValue(20)
+	assert.Equal(t, []int{1, 20, 3}, list.ToSlice())
+}
+
+func TestDoublyLinkedList_InsertNode(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Append(1, 3)
+	first := list.Front()
+
+	two := list.InsertAfterNode(first, 2)
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	list.InsertBeforeNode(first, 0)
+	list.InsertAfterNode(list.Back(), 4)
+	list.InsertBeforeNode(two, 15)
+	assert.Equal(t, []int{0, 1, 15, 2, 3, 4}, list.ToSlice())
+	assert.Equal(t, 0, list.Front().Value())
+	assert.Equal(t, 4, list.Back().Value())
+	checkLinks(t, list)
+
+	other := NewDoublyLinkedList[int]()
+	other.Append(9)
+	assert.Nil(t, list.InsertAfterNode(other.Front(), 5))
+	assert.Nil(t, list.InsertBeforeNode(nil, 5))
+	assert.Equal(t, 6, list.Length())
+}
+
+func TestDoublyLinkedList_RemoveNode(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Append(1, 2, 3)
+	head, mid, tail := list.Front(), list.Front().Next(), list.Back()
+
+	assert.True(t, list.RemoveNode(mid))
+	assert.Equal(t, []int{1, 3}, list.ToSlice())
+	assert.Nil(t, mid.Next())
+	assert.Nil(t, mid.Prev())
+	// a removed node cannot be removed again, or used as a mark
+	assert.False(t, list.RemoveNode(mid))
+	assert.Nil(t, list.InsertAfterNode(mid, 5))
+	checkLinks(t, list)
+
+	assert.True(t, list.RemoveNode(tail))
+	assert.Equal(t, head, list.Back())
+	assert.True(t, list.RemoveNode(head))
+	assert.True(t, list.IsEmpty())
+	assert.Nil(t, list.Back())
+
+	other := NewDoublyLinkedList[int]()
+	node := other.PushBack(1)
+	assert.False(t, list.RemoveNode(node))
+	assert.False(t, list.RemoveNode(nil))
+	assert.Equal(t, []int{1}, other.ToSlice())
+}
+
+func TestDoublyLinkedList_MoveToFrontBack(t *testing.T) {
+	list := NewDoublyLinkedList[string]()
+	a, b, c := list.PushBack("a"), list.PushBack("b"), list.PushBack("c")
+
+	assert.True(t, list.MoveToFront(c))
+	assert.Equal(t, []string{"c", "a", "b"}, list.ToSlice())
+	assert.True(t, list.MoveToFront(c))
+	assert.Equal(t, []string{"c", "a", "b"}, list.ToSlice())
+	assert.True(t, list.MoveToBack(a))
+	assert.Equal(t, []string{"c", "b", "a"}, list.ToSlice())
+	assert.True(t, list.MoveToBack(a))
+	assert.True(t, list.MoveToBack(c))
+	assert.Equal(t, []string{"b", "a", "c"}, list.ToSlice())
+	checkLinks(t, list)
+
+	list.RemoveNode(b)
+	assert.False(t, list.MoveToFront(b))
+	assert.False(t, list.MoveToBack(b))
+}
+
+func TestDoublyLinkedList_NodesDetachedByIndexRemoveAndUnmarshal(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Append(1, 2, 3)
+	node := list.Find(1)
+	list.Remove(1)
+	assert.False(t, list.RemoveNode(node))
+	assert.Equal(t, []int{1, 3}, list.ToSlice())
+
+	first := list.Front()
+	assert.Nil(t, list.UnmarshalJSON([]byte("[7,8]")))
+	assert.False(t, list.MoveToBack(first))
+	assert.Equal(t, []int{7, 8}, list.ToSlice())
+}
+
+func TestDoublyLinkedList_MergeMovesNodes(t *testing.T) {
+	list, other := NewDoublyLinkedList[int](), NewDoublyLinkedList[int]()
+	list.PushBack(1)
+	node := other.PushBack(2)
+	list.Merge(other)
+	assert.True(t, list.MoveToFront(node))
+	assert.Equal(t, []int{2, 1}, list.ToSlice())
+	checkLinks(t, list)
+}
+
+// TestDoublyLinkedList_LRU builds a small LRU cache on the node-based methods.
+func TestDoublyLinkedList_LRU(t *testing.T) {
+	const capacity = 2
+	order := NewDoublyLinkedList[string]()
+	nodes := map[string]*DoublyNode[string]{}
+	touch := func(key string) {
+		if node, ok := nodes[key]; ok {
+			order.MoveToFront(node)
+			return
+		}
+		nodes[key] = order.PushFront(key)
+		if len(nodes) > capacity {
+			oldest := order.Back()
+			order.RemoveNode(oldest)
+			delete(nodes, oldest.Value())
+		}
+	}
+	touch("a")
+	touch("b")
+	touch("a")
+	touch("c")
+	assert.Equal(t, []string{"c", "a"}, order.ToSlice())
+	assert.NotContains(t, nodes, "b")
+}
+
+func TestConcurrentDoublyLinkedList_PopRightValueDetachesNode(t *testing.T) {
+	c := NewConcurrentDoublyLinkedList[int]()
+	c.Append(1, 2)
+	node := c.Find(1)
+	val, ok := c.PopRightValue()
+	assert.True(t, ok)
+	assert.Equal(t, 2, val)
+	assert.Nil(t, node.Prev())
+	c.Compute(func(list *DoublyLinkedList[int]) {
+		assert.False(t, list.RemoveNode(node))
+		checkLinks(t, list)
+	})
+}
@@ -0,0 +1,106 @@
+//go:build go1.23
+
+package slinkedlist
+
+import "iter"
+
+// SinglyLinkedListFromSeq creates a new singly linked list from the values of seq.
+func SinglyLinkedListFromSeq[T comparable](seq iter.Seq[T]) *SinglyLinkedList[T] {
+	var list = NewSinglyLinkedList[T]()
+	for val := range seq {
+		list.Append(val)
+	}
+	return list
+}
+
+// All returns an iterator over the index-value pairs in the linked list, from head to tail.
+func (list *SinglyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for current := list.head; current != nil; current = current.next {
+			if !yield(i, current.val) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns an iterator over the values in the linked list, from head to tail.
+func (list *SinglyLinkedList[T]) Values() iter.Seq[T] {
+	return list.Iterate
+}
+
+// DoublyLinkedListFromSeq creates a new doubly linked list from the values of seq.
+func DoublyLinkedListFromSeq[T comparable](seq iter.Seq[T]) *DoublyLinkedList[T] {
+	var list = NewDoublyLinkedList[T]()
+	for val := range seq {
+		list.Append(val)
+	}
+	return list
+}
+
+// All returns an iterator over the index-value pairs in the linked list, from head to tail.
+func (list *DoublyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for current := list.head; current != nil; current = current.next {
+			if !yield(i, current.val) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns an iterator over the values in the linked list, from head to tail.
+func (list *DoublyLinkedList[T]) Values() iter.Seq[T] {
+	return list.Iterate
+}
+
+// Backward returns an iterator over the index-value pairs in the linked list, from tail to head.
+// The indexes are the same as those of All.
+func (list *DoublyLinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := list.Length() - 1
+		for current := list.tail; current != nil; current = current.prev {
+			if !yield(i, current.val) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// All returns an iterator over the index-value pairs in a snapshot of the linked list,
+// taken when the iteration starts.
+func (c *ConcurrentSinglyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		c.Snapshot().All()(yield)
+	}
+}
+
+// Values returns an iterator over the values in a snapshot of the linked list, taken when the iteration starts.
+func (c *ConcurrentSinglyLinkedList[T]) Values() iter.Seq[T] {
+	return c.Iterate
+}
+
+// All returns an iterator over the index-value pairs in a snapshot of the linked list,
+// taken when the iteration starts.
+func (c *ConcurrentDoublyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		c.Snapshot().All()(yield)
+	}
+}
+
+// Values returns an iterator over the values in a snapshot of the linked list, taken when the iteration starts.
+func (c *ConcurrentDoublyLinkedList[T]) Values() iter.Seq[T] {
+	return c.Iterate
+}
+
+// Backward returns an iterator over the index-value pairs in a snapshot of the linked list, from tail to head.
+func (c *ConcurrentDoublyLinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		c.Snapshot().Backward()(yield)
+	}
+}
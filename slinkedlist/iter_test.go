@@ -0,0 +1,47 @@
+//go:build go1.23
+
+package slinkedlist
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestSinglyLinkedList_All_RangeOverFunc tests ranging over All of a singly linked list.
+func TestSinglyLinkedList_All_RangeOverFunc(t *testing.T) {
+	list := NewSinglyLinkedList[string]()
+	list.Append("a", "b", "c")
+	var indexes []int
+	var values []string
+	for i, v := range list.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{0, 1, 2}, indexes)
+	assert.Equal(t, []string{"a", "b", "c"}, values)
+}
+
+// TestDoublyLinkedList_Backward_Break tests ranging over Backward of a doubly linked list with break.
+func TestDoublyLinkedList_Backward_Break(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Append(1, 2, 3)
+	var indexes, values []int
+	for i, v := range list.Backward() {
+		if v == 1 {
+			break
+		}
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{2, 1}, indexes)
+	assert.Equal(t, []int{3, 2}, values)
+}
+
+// TestFromSeq_Values_RoundTrip tests building linked lists from the Values of others.
+func TestFromSeq_Values_RoundTrip(t *testing.T) {
+	singly := NewSinglyLinkedList[int]()
+	singly.Append(1, 2, 3)
+	doubly := DoublyLinkedListFromSeq(singly.Values())
+	assert.Equal(t, []int{1, 2, 3}, doubly.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, SinglyLinkedListFromSeq(doubly.Values()).ToSlice())
+}
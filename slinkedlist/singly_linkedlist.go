@@ -12,6 +12,7 @@ type SinglyNode[T comparable] struct {
 
 type SinglyLinkedList[T comparable] struct {
 	head, tail *SinglyNode[T]
+	mods       int // counts the structural modifications, so that cursors can detect them
 }
 
 // NewSinglyLinkedList creates a new singly linked list.
@@ -24,6 +25,7 @@ func (list *SinglyLinkedList[T]) Append(val ...T) {
 	if len(val) == 0 {
 		return
 	}
+	list.mods++
 	newNode := &SinglyNode[T]{val: val[0], next: nil}
 	first := newNode
 	for i := 1; i < len(val); i++ {
@@ -52,6 +54,7 @@ func (list *SinglyLinkedList[T]) InsertBefore(index int, val T) error {
 	if index == 0 { // insert before head
 		newNode.next = list.head
 		list.head = newNode
+		list.mods++
 		return nil
 	}
 
@@ -70,6 +73,7 @@ func (list *SinglyLinkedList[T]) InsertBefore(index int, val T) error {
 	// do connect current -> newNode
 	newNode.next = current.next
 	current.next = newNode
+	list.mods++
 	// because insertion into the tail is not allowed here, there is no need to change the tail node
 	return nil
 }
@@ -92,9 +96,14 @@ func (list *SinglyLinkedList[T]) InsertAfter(index int, val T) error {
 			return fmt.Errorf("index out of range")
 		}
 	}
+	// insertion after the tail node is possible
+	if current.next == nil {
+		list.tail = newNode
+	}
 	// do insert operation
 	newNode.next = current.next
 	current.next = newNode
+	list.mods++
 	return nil
 }
 
@@ -106,6 +115,10 @@ func (list *SinglyLinkedList[T]) Remove(index int) {
 
 	if index == 0 {
 		list.head = list.head.next
+		if list.head == nil {
+			list.tail = nil
+		}
+		list.mods++
 		return
 	}
 
@@ -119,6 +132,10 @@ func (list *SinglyLinkedList[T]) Remove(index int) {
 	}
 
 	current.next = current.next.next
+	if current.next == nil { // the tail has been removed
+		list.tail = current
+	}
+	list.mods++
 }
 
 // IndexOf returns the index of the first occurrence of the specified value in the linked list.
@@ -165,6 +182,16 @@ func (list *SinglyLinkedList[T]) Walk(f func(T)) {
 	}
 }
 
+// Iterate calls f sequentially for each value in the linked list from head to tail.
+// If f returns false, Iterate stops the iteration.
+func (list *SinglyLinkedList[T]) Iterate(f func(T) bool) {
+	for current := list.head; current != nil; current = current.next {
+		if !f(current.val) {
+			return
+		}
+	}
+}
+
 // Reverse reverses the linked list.
 func (list *SinglyLinkedList[T]) Reverse() {
 	if list.head == nil || list.head.next == nil {
@@ -181,7 +208,9 @@ func (list *SinglyLinkedList[T]) Reverse() {
 		current = next
 	}
 
+	list.tail = list.head
 	list.head = prev
+	list.mods++
 }
 
 // Merge merges the current linked list with another linked list.
@@ -189,6 +218,8 @@ func (list *SinglyLinkedList[T]) Merge(other *SinglyLinkedList[T]) {
 	if other == nil || other.head == nil {
 		return
 	}
+	list.mods++
+	other.mods++
 	if list.head == nil {
 		list.head = other.head
 		list.tail = other.tail
@@ -253,3 +284,43 @@ func (list *SinglyLinkedList[T]) CountOf(val T) int {
 	}
 	return count
 }
+
+// clear removes all the nodes from the linked list.
+func (list *SinglyLinkedList[T]) clear() {
+	list.head, list.tail = nil, nil
+	list.mods++
+}
+
+// insertAfterNode inserts a new node with the specified value after prev, or at the head if prev is nil,
+// and returns it.
+func (list *SinglyLinkedList[T]) insertAfterNode(prev *SinglyNode[T], val T) *SinglyNode[T] {
+	node := &SinglyNode[T]{val: val}
+	if prev == nil {
+		node.next = list.head
+		list.head = node
+	} else {
+		node.next = prev.next
+		prev.next = node
+	}
+	if node.next == nil {
+		list.tail = node
+	}
+	list.mods++
+	return node
+}
+
+// removeAfterNode removes the node after prev, or the head if prev is nil.
+func (list *SinglyLinkedList[T]) removeAfterNode(prev *SinglyNode[T]) {
+	var next *SinglyNode[T]
+	if prev == nil {
+		list.head = list.head.next
+		next = list.head
+	} else {
+		prev.next = prev.next.next
+		next = prev.next
+	}
+	if next == nil { // the tail has been removed
+		list.tail = prev
+	}
+	list.mods++
+}
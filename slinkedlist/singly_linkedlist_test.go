@@ -88,7 +88,7 @@ func TestAppend(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			setTail(tt.list)
 			tt.list.Append(tt.val...)
-			if !reflect.DeepEqual(tt.list, tt.expected) {
+			if !reflect.DeepEqual(tt.list.head, tt.expected.head) || !reflect.DeepEqual(tt.list.tail, tt.expected.tail) {
 				printAllElements(tt.list)
 				t.Errorf("Append() = %+v, want %+v", tt.list, tt.expected)
 			}
@@ -696,3 +696,30 @@ func TestCountOf_MultipleNodesSomeMatching_ShouldReturnCount(t *testing.T) {
 	list.Append(1)
 	assert.Equal(t, 3, list.CountOf(1))
 }
+
+// TestSinglyLinkedList_Iterate_StopsWhenFalseReturned tests the Iterate method stops when f returns false.
+func TestSinglyLinkedList_Iterate_StopsWhenFalseReturned(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Append(1, 2, 3)
+	var visited []int
+	list.Iterate(func(val int) bool {
+		visited = append(visited, val)
+		return val < 2
+	})
+	assert.Equal(t, []int{1, 2}, visited)
+}
+
+// TestRemove_Tail_AppendKeepsWorking tests that removing the tail node updates the tail.
+func TestRemove_Tail_AppendKeepsWorking(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Append(1, 2, 3)
+	list.Remove(2)
+	list.Append(4)
+	assert.Equal(t, []int{1, 2, 4}, list.ToSlice())
+
+	list.Remove(0)
+	list.Remove(0)
+	list.Remove(0)
+	list.Append(5)
+	assert.Equal(t, []int{5}, list.ToSlice())
+}
@@ -0,0 +1,169 @@
+package slinkedlist
+
+// Sort sorts the linked list by less in O(n log n). It is a bottom-up merge sort that relinks the nodes
+// in place, so it allocates nothing and the nodes keep their identity.
+func (list *SinglyLinkedList[T]) Sort(less func(a, b T) bool) {
+	list.SortStable(less)
+}
+
+// SortStable sorts the linked list by less like Sort, and guarantees that equal values keep their original order.
+func (list *SinglyLinkedList[T]) SortStable(less func(a, b T) bool) {
+	if list.head == nil || list.head.next == nil {
+		return
+	}
+	var n = list.Length()
+	var dummy SinglyNode[T]
+	dummy.next = list.head
+	for width := 1; width < n; width *= 2 {
+		var tail = &dummy
+		var current = dummy.next
+		for current != nil {
+			left := current
+			right := splitSingly(left, width)
+			current = splitSingly(right, width)
+			tail.next, tail = mergeSingly(left, right, less)
+		}
+		list.tail = tail
+	}
+	list.head = dummy.next
+	list.mods++
+}
+
+// MergeSorted merges other into the linked list, when both are sorted by less, so that the linked list stays
+// sorted. It relinks the nodes of other without allocating, and equal values of the linked list come first.
+// other is empty afterwards.
+func (list *SinglyLinkedList[T]) MergeSorted(other *SinglyLinkedList[T], less func(a, b T) bool) {
+	if other == nil || other == list || other.head == nil {
+		return
+	}
+	list.head, list.tail = mergeSingly(list.head, other.head, less)
+	other.clear()
+	list.mods++
+}
+
+// splitSingly cuts the list after the first n nodes from node, and returns the rest.
+func splitSingly[T comparable](node *SinglyNode[T], n int) *SinglyNode[T] {
+	for i := 1; node != nil && i < n; i++ {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+// mergeSingly merges two sorted lists of nodes, taking from a on ties, and returns the head and tail.
+func mergeSingly[T comparable](a, b *SinglyNode[T], less func(a, b T) bool) (head, tail *SinglyNode[T]) {
+	var dummy SinglyNode[T]
+	tail = &dummy
+	for a != nil && b != nil {
+		if less(b.val, a.val) {
+			tail.next, b = b, b.next
+		} else {
+			tail.next, a = a, a.next
+		}
+		tail = tail.next
+	}
+	if a == nil {
+		a = b
+	}
+	tail.next = a
+	for tail.next != nil {
+		tail = tail.next
+	}
+	return dummy.next, tail
+}
+
+// Sort sorts the linked list by less in O(n log n). It is a bottom-up merge sort that relinks the nodes
+// in place, so it allocates nothing and the nodes keep their identity.
+func (list *DoublyLinkedList[T]) Sort(less func(a, b T) bool) {
+	list.SortStable(less)
+}
+
+// SortStable sorts the linked list by less like Sort, and guarantees that equal values keep their original order.
+func (list *DoublyLinkedList[T]) SortStable(less func(a, b T) bool) {
+	if list.head == nil || list.head.next == nil {
+		return
+	}
+	var n = list.Length()
+	var dummy DoublyNode[T]
+	dummy.next = list.head
+	for width := 1; width < n; width *= 2 {
+		var tail = &dummy
+		var current = dummy.next
+		for current != nil {
+			left := current
+			right := splitDoubly(left, width)
+			current = splitDoubly(right, width)
+			tail.next, tail = mergeDoubly(left, right, less)
+		}
+	}
+	list.head = dummy.next
+	list.relink()
+}
+
+// MergeSorted merges other into the linked list, when both are sorted by less, so that the linked list stays
+// sorted. It relinks the nodes of other without allocating, and equal values of the linked list come first.
+// other is empty afterwards, and its nodes belong to the linked list.
+func (list *DoublyLinkedList[T]) MergeSorted(other *DoublyLinkedList[T], less func(a, b T) bool) {
+	if other == nil || other == list || other.head == nil {
+		return
+	}
+	for node := other.head; node != nil; node = node.next {
+		node.list = list
+	}
+	list.head, _ = mergeDoubly(list.head, other.head, less)
+	list.relink()
+	other.head, other.tail = nil, nil
+	other.mods++
+}
+
+// relink restores the prev links and the tail from the next links, after the nodes have been relinked.
+func (list *DoublyLinkedList[T]) relink() {
+	var prev *DoublyNode[T]
+	for node := list.head; node != nil; node = node.next {
+		node.prev = prev
+		prev = node
+	}
+	list.tail = prev
+	list.mods++
+}
+
+// splitDoubly cuts the list after the first n nodes from node, and returns the rest.
+// Only the next links are maintained, see relink.
+func splitDoubly[T comparable](node *DoublyNode[T], n int) *DoublyNode[T] {
+	for i := 1; node != nil && i < n; i++ {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+// mergeDoubly merges two sorted lists of nodes, taking from a on ties, and returns the head and tail.
+// Only the next links are maintained, see relink.
+func mergeDoubly[T comparable](a, b *DoublyNode[T], less func(a, b T) bool) (head, tail *DoublyNode[T]) {
+	var dummy DoublyNode[T]
+	tail = &dummy
+	for a != nil && b != nil {
+		if less(b.val, a.val) {
+			tail.next, b = b, b.next
+		} else {
+			tail.next, a = a, a.next
+		}
+		tail = tail.next
+	}
+	if a == nil {
+		a = b
+	}
+	tail.next = a
+	for tail.next != nil {
+		tail = tail.next
+	}
+	return dummy.next, tail
+}
@@ -0,0 +1,183 @@
+package slinkedlist
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+type sortItem struct {
+	key, id int
+}
+
+func lessByKey(a, b sortItem) bool {
+	return a.key < b.key
+}
+
+func randomItems(r *rand.Rand, n int) []sortItem {
+	items := make([]sortItem, n)
+	for i := range items {
+		items[i] = sortItem{key: r.Intn(10), id: i}
+	}
+	return items
+}
+
+func sortedItems(items []sortItem) []sortItem {
+	sorted := append([]sortItem(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool { return lessByKey(sorted[i], sorted[j]) })
+	return sorted
+}
+
+func TestSinglyLinkedList_Sort(t *testing.T) {
+	list := NewSinglyLinkedList[int]()
+	list.Sort(func(a, b int) bool { return a < b })
+	assert.True(t, list.IsEmpty())
+
+	list.Append(3, 1, 2)
+	list.Sort(func(a, b int) bool { return a < b })
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	list.Sort(func(a, b int) bool { return a > b })
+	assert.Equal(t, []int{3, 2, 1}, list.ToSlice())
+	list.Append(0)
+	assert.Equal(t, []int{3, 2, 1, 0}, list.ToSlice())
+	checkTail(t, list)
+
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 16, 17, 100, 1000} {
+		items := randomItems(r, n)
+		list := NewSinglyLinkedList[sortItem]()
+		list.Append(items...)
+		list.SortStable(lessByKey)
+		assert.Equal(t, sortedItems(items), list.ToSlice())
+		checkTail(t, list)
+	}
+}
+
+func TestDoublyLinkedList_Sort(t *testing.T) {
+	list := NewDoublyLinkedList[int]()
+	list.Sort(func(a, b int) bool { return a < b })
+	assert.True(t, list.IsEmpty())
+
+	list.Append(3, 1, 2)
+	one := list.Find(1)
+	list.Sort(func(a, b int) bool { return a < b })
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.Equal(t, one, list.Front())
+	checkLinks(t, list)
+
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 16, 17, 100, 1000} {
+		items := randomItems(r, n)
+		list := NewDoublyLinkedList[sortItem]()
+		list.Append(items...)
+		list.SortStable(lessByKey)
+		assert.Equal(t, sortedItems(items), list.ToSlice())
+		checkLinks(t, list)
+	}
+}
+
+func TestSinglyLinkedList_MergeSorted(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range [][2]int{{0, 0}, {0, 3}, {3, 0}, {5, 5}, {1, 20}, {50, 30}} {
+		a, b := sortedItems(randomItems(r, n[0])), sortedItems(randomItems(r, n[1]))
+		for i := range b {
+			b[i].id += n[0] // so that the items of b are known to come after those of a
+		}
+		list, other := NewSinglyLinkedList[sortItem](), NewSinglyLinkedList[sortItem]()
+		list.Append(a...)
+		other.Append(b...)
+		list.MergeSorted(other, lessByKey)
+
+		want := sortedItems(append(append([]sortItem(nil), a...), b...))
+		if len(want) == 0 {
+			assert.True(t, list.IsEmpty())
+		} else {
+			assert.Equal(t, want, list.ToSlice())
+		}
+		assert.True(t, other.IsEmpty())
+		checkTail(t, list)
+		checkTail(t, other)
+	}
+
+	list := NewSinglyLinkedList[int]()
+	list.Append(1, 3)
+	list.MergeSorted(list, func(a, b int) bool { return a < b })
+	list.MergeSorted(nil, func(a, b int) bool { return a < b })
+	assert.Equal(t, []int{1, 3}, list.ToSlice())
+}
+
+func TestDoublyLinkedList_MergeSorted(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range [][2]int{{0, 0}, {0, 3}, {3, 0}, {5, 5}, {1, 20}, {50, 30}} {
+		a, b := sortedItems(randomItems(r, n[0])), sortedItems(randomItems(r, n[1]))
+		for i := range b {
+			b[i].id += n[0]
+		}
+		list, other := NewDoublyLinkedList[sortItem](), NewDoublyLinkedList[sortItem]()
+		list.Append(a...)
+		other.Append(b...)
+		list.MergeSorted(other, lessByKey)
+
+		want := sortedItems(append(append([]sortItem(nil), a...), b...))
+		if len(want) == 0 {
+			assert.True(t, list.IsEmpty())
+		} else {
+			assert.Equal(t, want, list.ToSlice())
+		}
+		assert.True(t, other.IsEmpty())
+		checkLinks(t, list)
+		checkLinks(t, other)
+	}
+
+	// a cursor on either list detects the merge
+	list, other := NewDoublyLinkedList[int](), NewDoublyLinkedList[int]()
+	list.Append(1, 3)
+	other.Append(2)
+	c := other.Cursor()
+	list.MergeSorted(other, func(a, b int) bool { return a < b })
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.False(t, c.Next())
+	assert.Equal(t, ErrConcurrentModification, c.Err())
+	assert.True(t, list.RemoveNode(list.Find(1)))
+	assert.Equal(t, []int{1, 3}, list.ToSlice())
+}
+
+func TestConcurrentLinkedList_Sort(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	singly, singlyOther := NewConcurrentSinglyLinkedList[int](), NewConcurrentSinglyLinkedList[int]()
+	singly.Append(5, 1, 3)
+	singly.Sort(less)
+	singlyOther.Append(4, 2)
+	singlyOther.SortStable(less)
+	singly.MergeSorted(singlyOther, less)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, singly.ToSlice())
+	assert.Equal(t, []int{2, 4}, singlyOther.ToSlice())
+
+	doubly, doublyOther := NewConcurrentDoublyLinkedList[int](), NewConcurrentDoublyLinkedList[int]()
+	doubly.Append(5, 1, 3)
+	doubly.Sort(less)
+	doublyOther.Append(4, 2)
+	doublyOther.SortStable(less)
+	doubly.MergeSorted(doublyOther, less)
+	doubly.MergeSorted(doubly, less)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, doubly.ToSlice())
+	assert.Equal(t, []int{2, 4}, doublyOther.ToSlice())
+}
+
+func BenchmarkDoublyLinkedList_Sort(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	values := make([]int, 10000)
+	for i := range values {
+		values[i] = r.Int()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := NewDoublyLinkedList[int]()
+		list.Append(values...)
+		b.StartTimer()
+		list.Sort(func(a, b int) bool { return a < b })
+	}
+}
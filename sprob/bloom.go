@@ -0,0 +1,264 @@
+package sprob
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/chaseSpace/bear/internal/hashing"
+	"math"
+	"math/bits"
+)
+
+// ErrIncompatible is returned when combining filters that differ in size or number of hash functions.
+var ErrIncompatible = errors.New("incompatible filters: different size or hash count")
+
+// BloomFilter is a probabilistic set, which tells whether an item is definitely not present or probably present.
+// It never gives false negatives, and gives false positives at about the rate it was created for,
+// as long as it holds no more than the expected number of items.
+// It takes about 9.6 bits per item for a 1% false-positive rate, whatever the size of the items.
+//
+// Items cannot be deleted from BloomFilter; use CountingBloomFilter for that.
+// The zero BloomFilter is only useful as the target of UnmarshalBinary.
+type BloomFilter[T comparable] struct {
+	words  []uint64
+	m      uint64 // number of bits
+	k      int    // number of hash functions
+	hasher func(T) uint64
+}
+
+// NewBloom returns a new BloomFilter sized for the expected number of items and the target false-positive rate.
+// If expected <= 0, it uses 1. If fpRate is not in (0, 1), it uses 0.01.
+// The number of hash functions is capped at 64, so a fpRate below about 5e-20 is not fully reached.
+// It uses the same default hasher as sset.ShardedSet, whose hashes are stable across processes except for
+// pointers, so a serialized filter can be loaded by another process. For struct types, NewBloomWithHasher is faster.
+func NewBloom[T comparable](expected int, fpRate float64) *BloomFilter[T] {
+	return NewBloomWithHasher(expected, fpRate, hashing.Default[T]())
+}
+
+// NewBloomWithHasher returns a new BloomFilter like NewBloom, which uses hasher to hash the items.
+// Equal items must have the same hash.
+func NewBloomWithHasher[T comparable](expected int, fpRate float64, hasher func(T) uint64) *BloomFilter[T] {
+	m, k := optimalParams(expected, fpRate)
+	return &BloomFilter[T]{words: make([]uint64, wordCount(m)), m: m, k: k, hasher: hasher}
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to BloomFilter
+func (b *BloomFilter[T]) Add(data ...T) *BloomFilter[T] {
+	for _, item := range data {
+		locations(b.hasher(item), b.k, b.m, func(i uint64) bool {
+			b.words[i>>6] |= 1 << (i & 63)
+			return true
+		})
+	}
+	return b
+}
+
+// Clone returns a copy of BloomFilter
+func (b *BloomFilter[T]) Clone() *BloomFilter[T] {
+	var newFilter = *b
+	newFilter.words = make([]uint64, len(b.words))
+	copy(newFilter.words, b.words)
+	return &newFilter
+}
+
+// Clear clears BloomFilter
+func (b *BloomFilter[T]) Clear() *BloomFilter[T] {
+	for i := range b.words {
+		b.words[i] = 0
+	}
+	return b
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Has checks if BloomFilter may contain item. If it returns false, item has never been added.
+func (b *BloomFilter[T]) Has(item T) bool {
+	var found = true
+	locations(b.hasher(item), b.k, b.m, func(i uint64) bool {
+		found = b.words[i>>6]&(1<<(i&63)) != 0
+		return found
+	})
+	return found
+}
+
+// Union returns a new BloomFilter which may contain the items of BloomFilter and others.
+// It is the same as a filter to which all the items have been added.
+// The filters must have been created with the same parameters and hasher, otherwise it returns ErrIncompatible.
+func (b *BloomFilter[T]) Union(others ...*BloomFilter[T]) (*BloomFilter[T], error) {
+	var newFilter = b.Clone()
+	for _, other := range others {
+		if !b.compatible(other) {
+			return nil, ErrIncompatible
+		}
+		for i, w := range other.words {
+			newFilter.words[i] |= w
+		}
+	}
+	return newFilter, nil
+}
+
+// Intersect returns a new BloomFilter which may contain the items in all of BloomFilter and others.
+// Its false-positive rate is at least that of a filter to which only the common items have been added.
+// The filters must have been created with the same parameters and hasher, otherwise it returns ErrIncompatible.
+func (b *BloomFilter[T]) Intersect(others ...*BloomFilter[T]) (*BloomFilter[T], error) {
+	var newFilter = b.Clone()
+	for _, other := range others {
+		if !b.compatible(other) {
+			return nil, ErrIncompatible
+		}
+		for i, w := range other.words {
+			newFilter.words[i] &= w
+		}
+	}
+	return newFilter, nil
+}
+
+// Size returns the estimated number of distinct items added to BloomFilter.
+func (b *BloomFilter[T]) Size() int {
+	var set uint64
+	for _, w := range b.words {
+		set += uint64(bits.OnesCount64(w))
+	}
+	if set == b.m {
+		return math.MaxInt
+	}
+	m := float64(b.m)
+	return int(math.Round(-m / float64(b.k) * math.Log(1-float64(set)/m)))
+}
+
+// FalsePositiveRate returns the estimated false-positive rate of BloomFilter with the items added so far.
+func (b *BloomFilter[T]) FalsePositiveRate() float64 {
+	var set int
+	for _, w := range b.words {
+		set += bits.OnesCount64(w)
+	}
+	return math.Pow(float64(set)/float64(b.m), float64(b.k))
+}
+
+// Bits returns the number of bits of BloomFilter.
+func (b *BloomFilter[T]) Bits() uint64 {
+	return b.m
+}
+
+// HashCount returns the number of hash functions of BloomFilter.
+func (b *BloomFilter[T]) HashCount() int {
+	return b.k
+}
+
+// IsEmpty checks if BloomFilter is empty
+func (b *BloomFilter[T]) IsEmpty() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The data is a 'B' byte, the number of bits and
+// the number of hash functions as uvarint, followed by the 64-bit words of the bits in little-endian order.
+func (b *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	var data = make([]byte, 1+2*binary.MaxVarintLen64+8*len(b.words))
+	n := putHeader(data, bloomMagic, b.m, b.k)
+	for _, w := range b.words {
+		binary.LittleEndian.PutUint64(data[n:], w)
+		n += 8
+	}
+	return data[:n], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the parameters and items of BloomFilter.
+// The hasher is kept, or the default one is used for a zero BloomFilter.
+func (b *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	m, k, data, err := readHeader(data, bloomMagic)
+	if err != nil {
+		return err
+	}
+	if n := wordCount(m); uint64(len(data))%8 != 0 || uint64(len(data))/8 != n {
+		return fmt.Errorf("invalid BloomFilter data: want %d words, got %d bytes", n, len(data))
+	}
+	var words = make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	b.words, b.m, b.k = words, m, k
+	if b.hasher == nil {
+		b.hasher = hashing.Default[T]()
+	}
+	return nil
+}
+
+// ---------------------- internal ----------------------
+
+const (
+	bloomMagic         = 'B'
+	countingBloomMagic = 'C'
+	defaultFPRate      = 0.01
+	maxHashCount       = 64 // the most hash functions a filter uses, so that a decoder can bound them
+)
+
+func (b *BloomFilter[T]) compatible(other *BloomFilter[T]) bool {
+	return b.m == other.m && b.k == other.k
+}
+
+// optimalParams returns the number of bits m and hash functions k that give the false-positive rate fpRate
+// for n items, which are m = -n*ln(fpRate)/ln(2)^2 and k = m/n*ln(2). k is at most maxHashCount,
+// which is only reached by fpRate below about 5e-20.
+func optimalParams(n int, fpRate float64) (uint64, int) {
+	if n <= 0 {
+		n = 1
+	}
+	if !(fpRate > 0 && fpRate < 1) {
+		fpRate = defaultFPRate
+	}
+	m := math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2))
+	k := int(math.Round(m / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	} else if k > maxHashCount {
+		k = maxHashCount
+	}
+	return uint64(m), k
+}
+
+// locations calls f with the k bit indexes of hash h in [0, m), until f returns false.
+// It derives the indexes from two hashes as h1 + i*h2 (Kirsch and Mitzenmacher), which is as good as
+// k independent hash functions for a Bloom filter.
+func locations(h uint64, k int, m uint64, f func(uint64) bool) {
+	h1, h2 := h, hashing.Mix64(h)|1
+	for i := 0; i < k; i++ {
+		if !f((h1 + uint64(i)*h2) % m) {
+			return
+		}
+	}
+}
+
+// wordCount returns the number of 64-bit words to hold m bits.
+func wordCount(m uint64) uint64 {
+	return m/64 + (m%64+63)/64
+}
+
+func putHeader(data []byte, magic byte, m uint64, k int) int {
+	data[0] = magic
+	n := 1 + binary.PutUvarint(data[1:], m)
+	return n + binary.PutUvarint(data[n:], uint64(k))
+}
+
+func readHeader(data []byte, magic byte) (m uint64, k int, rest []byte, err error) {
+	if len(data) == 0 || data[0] != magic {
+		return 0, 0, nil, errors.New("invalid filter data: bad magic")
+	}
+	data = data[1:]
+	m, size := binary.Uvarint(data)
+	if size <= 0 || m == 0 {
+		return 0, 0, nil, errors.New("invalid filter data: bad bit count")
+	}
+	data = data[size:]
+	hashCount, size := binary.Uvarint(data)
+	if size <= 0 || hashCount == 0 || hashCount > maxHashCount {
+		return 0, 0, nil, errors.New("invalid filter data: bad hash count")
+	}
+	return m, int(hashCount), data[size:], nil
+}
@@ -0,0 +1,163 @@
+package sprob
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestNewBloom_Params(t *testing.T) {
+	b := NewBloom[int](1000, 0.01)
+	// m = -n*ln(p)/ln(2)^2 ≈ 9585.06, k = m/n*ln(2) ≈ 6.64
+	assert.Equal(t, uint64(9586), b.Bits())
+	assert.Equal(t, 7, b.HashCount())
+
+	d := NewBloom[int](0, 2)
+	assert.Equal(t, NewBloom[int](1, 0.01).Bits(), d.Bits())
+}
+
+func TestBloomFilter_AddHas(t *testing.T) {
+	b := NewBloom[string](100, 0.01)
+	assert.True(t, b.IsEmpty())
+	b.Add("a", "b")
+	assert.True(t, b.Has("a"))
+	assert.True(t, b.Has("b"))
+	assert.False(t, b.Has("c"))
+	assert.False(t, b.IsEmpty())
+	assert.Equal(t, 2, b.Size())
+
+	c := b.Clone().Add("c")
+	assert.True(t, c.Has("c"))
+	assert.False(t, b.Has("c"))
+
+	b.Clear()
+	assert.False(t, b.Has("a"))
+}
+
+func TestBloomFilter_FalsePositiveRate(t *testing.T) {
+	const n = 20000
+	for _, p := range []float64{0.1, 0.01, 0.001} {
+		b := NewBloom[int](n, p)
+		for i := 0; i < n; i++ {
+			b.Add(i)
+		}
+		for i := 0; i < n; i++ {
+			if !b.Has(i) {
+				t.Fatalf("false negative for %d", i)
+			}
+		}
+		var fp int
+		for i := n; i < 11*n; i++ {
+			if b.Has(i) {
+				fp++
+			}
+		}
+		rate := float64(fp) / (10 * n)
+		assert.Less(t, rate, 1.5*p, "p=%v", p)
+		assert.InDelta(t, p, b.FalsePositiveRate(), p/2)
+		assert.InEpsilon(t, n, b.Size(), 0.05)
+	}
+}
+
+func TestBloomFilter_StringsAndCustomHasher(t *testing.T) {
+	type key struct {
+		tenant string
+		id     int
+	}
+	b := NewBloomWithHasher(1000, 0.01, func(k key) uint64 { return uint64(k.id) * 0x9e3779b97f4a7c15 })
+	for i := 0; i < 1000; i++ {
+		b.Add(key{"t", i})
+	}
+	assert.True(t, b.Has(key{"t", 999}))
+
+	s := NewBloom[string](1000, 0.01)
+	var fp int
+	for i := 0; i < 1000; i++ {
+		s.Add("user:" + strconv.Itoa(i))
+	}
+	for i := 1000; i < 11000; i++ {
+		if s.Has("user:" + strconv.Itoa(i)) {
+			fp++
+		}
+	}
+	assert.Less(t, fp, 150)
+}
+
+func TestBloomFilter_UnionIntersect(t *testing.T) {
+	a := NewBloom[int](100, 0.01).Add(1, 2, 3)
+	b := NewBloom[int](100, 0.01).Add(3, 4)
+
+	u, err := a.Union(b)
+	assert.Nil(t, err)
+	for _, v := range []int{1, 2, 3, 4} {
+		assert.True(t, u.Has(v))
+	}
+	assert.Equal(t, NewBloom[int](100, 0.01).Add(1, 2, 3, 4).words, u.words)
+	assert.False(t, a.Has(4))
+
+	i, err := a.Intersect(b)
+	assert.Nil(t, err)
+	assert.True(t, i.Has(3))
+	assert.False(t, i.Has(1))
+
+	_, err = a.Union(NewBloom[int](200, 0.01))
+	assert.Equal(t, ErrIncompatible, err)
+	_, err = a.Intersect(NewBloom[int](100, 0.001))
+	assert.Equal(t, ErrIncompatible, err)
+}
+
+func TestBloomFilter_Binary(t *testing.T) {
+	b := NewBloom[string](1000, 0.01).Add("a", "b")
+	data, err := b.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded BloomFilter[string]
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.True(t, decoded.Has("a"))
+	assert.True(t, decoded.Has("b"))
+	assert.False(t, decoded.Has("c"))
+	assert.Equal(t, b.Bits(), decoded.Bits())
+	assert.Equal(t, b.HashCount(), decoded.HashCount())
+
+	assert.NotNil(t, decoded.UnmarshalBinary(nil))
+	assert.NotNil(t, decoded.UnmarshalBinary(data[:len(data)-1]))
+	assert.NotNil(t, decoded.UnmarshalBinary(append([]byte{'C'}, data[1:]...)))
+	// a huge bit count must not be allocated before the length is checked
+	assert.NotNil(t, decoded.UnmarshalBinary([]byte{'B', 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 7}))
+}
+
+func BenchmarkBloomFilter_Has(b *testing.B) {
+	f := NewBloom[string](1e6, 0.01)
+	for i := 0; i < 1e6; i++ {
+		f.Add("user:" + strconv.Itoa(i))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.Has("user:42")
+	}
+}
+
+func TestBloomFilter_TinyFPRate_RoundTrips(t *testing.T) {
+	// k = -log2(fpRate) reaches maxHashCount around fpRate = 5e-20, and is capped beyond it
+	for _, fpRate := range []float64{1e-19, 5e-20, 1e-20, 1e-300} {
+		b := NewBloom[int](10, fpRate).Add(1, 2)
+		assert.LessOrEqual(t, b.HashCount(), maxHashCount)
+		data, err := b.MarshalBinary()
+		assert.Nil(t, err)
+		var decoded BloomFilter[int]
+		assert.Nil(t, decoded.UnmarshalBinary(data), "fpRate %v", fpRate)
+		assert.Equal(t, b.HashCount(), decoded.HashCount())
+		assert.True(t, decoded.Has(1))
+		assert.True(t, decoded.Has(2))
+
+		c := NewCountingBloom[int](10, fpRate).Add(1, 2)
+		assert.LessOrEqual(t, c.HashCount(), maxHashCount)
+		data, err = c.MarshalBinary()
+		assert.Nil(t, err)
+		var decodedCounting CountingBloomFilter[int]
+		assert.Nil(t, decodedCounting.UnmarshalBinary(data), "fpRate %v", fpRate)
+		assert.Equal(t, c.HashCount(), decodedCounting.HashCount())
+		assert.True(t, decodedCounting.Has(1))
+	}
+	assert.Equal(t, maxHashCount, NewBloom[int](10, 1e-300).HashCount())
+}
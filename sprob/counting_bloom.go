@@ -0,0 +1,225 @@
+package sprob
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/chaseSpace/bear/internal/hashing"
+)
+
+// CountingBloomFilter is a BloomFilter that supports deletion. It keeps a 4-bit counter instead of a bit
+// for each location, so it takes 4 times the memory of a BloomFilter with the same parameters.
+//
+// A counter stops at 15 and is never decremented after that, so deleting never causes false negatives,
+// though it may leave some locations set. Only delete items that have been added, otherwise the
+// counters of other items may be decremented and they may be reported as not present.
+// The zero CountingBloomFilter is only useful as the target of UnmarshalBinary.
+type CountingBloomFilter[T comparable] struct {
+	counters []byte // two counters per byte, the lower one in the low nibble
+	m        uint64 // number of counters
+	k        int    // number of hash functions
+	hasher   func(T) uint64
+}
+
+// NewCountingBloom returns a new CountingBloomFilter sized for the expected number of items and the
+// target false-positive rate, in the same way as NewBloom.
+func NewCountingBloom[T comparable](expected int, fpRate float64) *CountingBloomFilter[T] {
+	return NewCountingBloomWithHasher(expected, fpRate, hashing.Default[T]())
+}
+
+// NewCountingBloomWithHasher returns a new CountingBloomFilter like NewCountingBloom, which uses hasher to
+// hash the items. Equal items must have the same hash.
+func NewCountingBloomWithHasher[T comparable](expected int, fpRate float64, hasher func(T) uint64) *CountingBloomFilter[T] {
+	m, k := optimalParams(expected, fpRate)
+	return &CountingBloomFilter[T]{counters: make([]byte, counterBytes(m)), m: m, k: k, hasher: hasher}
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to CountingBloomFilter
+func (b *CountingBloomFilter[T]) Add(data ...T) *CountingBloomFilter[T] {
+	for _, item := range data {
+		locations(b.hasher(item), b.k, b.m, func(i uint64) bool {
+			if c := b.counter(i); c < maxCount {
+				b.setCounter(i, c+1)
+			}
+			return true
+		})
+	}
+	return b
+}
+
+// Delete deletes data from CountingBloomFilter. The items that are not present are ignored.
+func (b *CountingBloomFilter[T]) Delete(data ...T) *CountingBloomFilter[T] {
+	for _, item := range data {
+		h := b.hasher(item)
+		if !b.has(h) {
+			continue
+		}
+		locations(h, b.k, b.m, func(i uint64) bool {
+			if c := b.counter(i); c < maxCount {
+				b.setCounter(i, c-1)
+			}
+			return true
+		})
+	}
+	return b
+}
+
+// Clone returns a copy of CountingBloomFilter
+func (b *CountingBloomFilter[T]) Clone() *CountingBloomFilter[T] {
+	var newFilter = *b
+	newFilter.counters = make([]byte, len(b.counters))
+	copy(newFilter.counters, b.counters)
+	return &newFilter
+}
+
+// Clear clears CountingBloomFilter
+func (b *CountingBloomFilter[T]) Clear() *CountingBloomFilter[T] {
+	for i := range b.counters {
+		b.counters[i] = 0
+	}
+	return b
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Has checks if CountingBloomFilter may contain item. If it returns false, item is not present.
+func (b *CountingBloomFilter[T]) Has(item T) bool {
+	return b.has(b.hasher(item))
+}
+
+// Count returns the estimated number of times item has been added, which is never less than the real one
+// unless the counters are saturated. It is 0 if item is not present.
+func (b *CountingBloomFilter[T]) Count(item T) int {
+	var least = maxCount
+	locations(b.hasher(item), b.k, b.m, func(i uint64) bool {
+		if c := b.counter(i); c < least {
+			least = c
+		}
+		return least > 0
+	})
+	return int(least)
+}
+
+// Union returns a new CountingBloomFilter which may contain the items of CountingBloomFilter and others.
+// The counters are summed, so each item added to any of the filters can still be deleted from the result.
+// The filters must have been created with the same parameters and hasher, otherwise it returns ErrIncompatible.
+func (b *CountingBloomFilter[T]) Union(others ...*CountingBloomFilter[T]) (*CountingBloomFilter[T], error) {
+	return b.combine(others, func(x, y byte) byte {
+		if x+y > maxCount {
+			return maxCount
+		}
+		return x + y
+	})
+}
+
+// Intersect returns a new CountingBloomFilter which may contain the items in all of CountingBloomFilter and others.
+// It keeps the least of the counters.
+// The filters must have been created with the same parameters and hasher, otherwise it returns ErrIncompatible.
+func (b *CountingBloomFilter[T]) Intersect(others ...*CountingBloomFilter[T]) (*CountingBloomFilter[T], error) {
+	return b.combine(others, func(x, y byte) byte {
+		if y < x {
+			return y
+		}
+		return x
+	})
+}
+
+// BloomFilter returns a new BloomFilter with the same items, which takes a quarter of the memory.
+func (b *CountingBloomFilter[T]) BloomFilter() *BloomFilter[T] {
+	var newFilter = &BloomFilter[T]{words: make([]uint64, wordCount(b.m)), m: b.m, k: b.k, hasher: b.hasher}
+	for i := uint64(0); i < b.m; i++ {
+		if b.counter(i) > 0 {
+			newFilter.words[i>>6] |= 1 << (i & 63)
+		}
+	}
+	return newFilter
+}
+
+// Bits returns the number of counters of CountingBloomFilter.
+func (b *CountingBloomFilter[T]) Bits() uint64 {
+	return b.m
+}
+
+// HashCount returns the number of hash functions of CountingBloomFilter.
+func (b *CountingBloomFilter[T]) HashCount() int {
+	return b.k
+}
+
+// IsEmpty checks if CountingBloomFilter is empty
+func (b *CountingBloomFilter[T]) IsEmpty() bool {
+	for _, c := range b.counters {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The data is a 'C' byte, the number of counters and
+// the number of hash functions as uvarint, followed by the counters, two per byte, the first one in the low nibble.
+func (b *CountingBloomFilter[T]) MarshalBinary() ([]byte, error) {
+	var data = make([]byte, 1+2*binary.MaxVarintLen64+len(b.counters))
+	n := putHeader(data, countingBloomMagic, b.m, b.k)
+	n += copy(data[n:], b.counters)
+	return data[:n], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the parameters and items of CountingBloomFilter.
+// The hasher is kept, or the default one is used for a zero CountingBloomFilter.
+func (b *CountingBloomFilter[T]) UnmarshalBinary(data []byte) error {
+	m, k, data, err := readHeader(data, countingBloomMagic)
+	if err != nil {
+		return err
+	}
+	if n := counterBytes(m); uint64(len(data)) != n {
+		return fmt.Errorf("invalid CountingBloomFilter data: want %d bytes of counters, got %d", n, len(data))
+	}
+	var counters = make([]byte, len(data))
+	copy(counters, data)
+	b.counters, b.m, b.k = counters, m, k
+	if b.hasher == nil {
+		b.hasher = hashing.Default[T]()
+	}
+	return nil
+}
+
+// ---------------------- internal ----------------------
+
+const maxCount byte = 15
+
+func (b *CountingBloomFilter[T]) has(h uint64) bool {
+	var found = true
+	locations(h, b.k, b.m, func(i uint64) bool {
+		found = b.counter(i) > 0
+		return found
+	})
+	return found
+}
+
+func (b *CountingBloomFilter[T]) counter(i uint64) byte {
+	return b.counters[i>>1] >> ((i & 1) * 4) & 0xf
+}
+
+func (b *CountingBloomFilter[T]) setCounter(i uint64, c byte) {
+	shift := (i & 1) * 4
+	b.counters[i>>1] = b.counters[i>>1]&^(0xf<<shift) | c<<shift
+}
+
+func (b *CountingBloomFilter[T]) combine(others []*CountingBloomFilter[T], op func(x, y byte) byte) (*CountingBloomFilter[T], error) {
+	var newFilter = b.Clone()
+	for _, other := range others {
+		if b.m != other.m || b.k != other.k {
+			return nil, ErrIncompatible
+		}
+		for i := uint64(0); i < b.m; i++ {
+			newFilter.setCounter(i, op(newFilter.counter(i), other.counter(i)))
+		}
+	}
+	return newFilter, nil
+}
+
+// counterBytes returns the number of bytes to hold m 4-bit counters.
+func counterBytes(m uint64) uint64 {
+	return m/2 + m%2
+}
@@ -0,0 +1,99 @@
+package sprob
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCountingBloomFilter_AddDelete(t *testing.T) {
+	b := NewCountingBloom[string](100, 0.01)
+	b.Add("a", "a", "b")
+	assert.True(t, b.Has("a"))
+	assert.True(t, b.Has("b"))
+	assert.Equal(t, 2, b.Count("a"))
+	assert.Equal(t, 0, b.Count("c"))
+
+	b.Delete("a")
+	assert.True(t, b.Has("a"))
+	b.Delete("a", "c")
+	assert.False(t, b.Has("a"))
+	assert.True(t, b.Has("b"))
+
+	b.Delete("b")
+	assert.True(t, b.IsEmpty())
+}
+
+func TestCountingBloomFilter_NoFalseNegativesAfterDeletes(t *testing.T) {
+	const n = 5000
+	b := NewCountingBloom[int](n, 0.01)
+	for i := 0; i < n; i++ {
+		b.Add(i)
+	}
+	for i := 0; i < n; i += 2 {
+		b.Delete(i)
+	}
+	var present int
+	for i := 0; i < n; i++ {
+		if i%2 == 1 && !b.Has(i) {
+			t.Fatalf("false negative for %d", i)
+		}
+		if i%2 == 0 && b.Has(i) {
+			present++
+		}
+	}
+	assert.Less(t, present, n/2/20)
+}
+
+func TestCountingBloomFilter_Saturation(t *testing.T) {
+	b := NewCountingBloom[int](10, 0.01)
+	for i := 0; i < 20; i++ {
+		b.Add(1)
+	}
+	assert.Equal(t, 15, b.Count(1))
+	for i := 0; i < 20; i++ {
+		b.Delete(1)
+	}
+	// saturated counters are never decremented
+	assert.True(t, b.Has(1))
+}
+
+func TestCountingBloomFilter_UnionIntersect(t *testing.T) {
+	a := NewCountingBloom[int](100, 0.01).Add(1, 2)
+	b := NewCountingBloom[int](100, 0.01).Add(2, 3)
+
+	u, err := a.Union(b)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, u.Count(2))
+	u.Delete(2)
+	assert.True(t, u.Has(2))
+	assert.True(t, u.Has(1))
+	assert.True(t, u.Has(3))
+
+	i, err := a.Intersect(b)
+	assert.Nil(t, err)
+	assert.True(t, i.Has(2))
+	assert.False(t, i.Has(1))
+
+	_, err = a.Union(NewCountingBloom[int](1000, 0.01))
+	assert.Equal(t, ErrIncompatible, err)
+}
+
+func TestCountingBloomFilter_BloomFilterAndBinary(t *testing.T) {
+	b := NewCountingBloom[int](1000, 0.01).Add(1, 2, 3).Delete(2)
+	plain := b.BloomFilter()
+	assert.True(t, plain.Has(1))
+	assert.False(t, plain.Has(2))
+	assert.Equal(t, NewBloom[int](1000, 0.01).Add(1, 3).words, plain.words)
+
+	data, err := b.MarshalBinary()
+	assert.Nil(t, err)
+	var decoded CountingBloomFilter[int]
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, b.counters, decoded.counters)
+	decoded.Delete(1)
+	assert.False(t, decoded.Has(1))
+
+	assert.NotNil(t, decoded.UnmarshalBinary(data[:len(data)-1]))
+	plainData, _ := plain.MarshalBinary()
+	assert.NotNil(t, decoded.UnmarshalBinary(plainData))
+}
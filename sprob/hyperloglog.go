@@ -0,0 +1,196 @@
+package sprob
+
+import (
+	"errors"
+	"fmt"
+	"github.com/chaseSpace/bear/internal/hashing"
+	"github.com/chaseSpace/bear/sset"
+	"math"
+	"math/bits"
+)
+
+// HyperLogLog estimates the number of distinct items added to it, using 2^precision one-byte registers
+// whatever the number of items. The standard error of the estimate is about 1.04/sqrt(2^precision),
+// e.g. 0.81% for the default precision 14, which takes 16 KiB.
+// The zero HyperLogLog is only useful as the target of UnmarshalBinary.
+type HyperLogLog[T comparable] struct {
+	registers []uint8
+	p         uint8
+	hasher    func(T) uint64
+}
+
+const (
+	// MinPrecision is the least precision of HyperLogLog, which has a standard error of 26%.
+	MinPrecision = 4
+	// MaxPrecision is the greatest precision of HyperLogLog, which has a standard error of 0.2% and takes 256 KiB.
+	MaxPrecision = 18
+	// DefaultPrecision is the precision of HyperLogLog if an invalid one is given.
+	DefaultPrecision = 14
+)
+
+// NewHyperLogLog returns a new HyperLogLog with 2^precision registers.
+// If precision is not in [MinPrecision, MaxPrecision], it uses DefaultPrecision.
+// It uses the same default hasher as NewBloom. For struct types, NewHyperLogLogWithHasher is faster.
+func NewHyperLogLog[T comparable](precision int) *HyperLogLog[T] {
+	return NewHyperLogLogWithHasher(precision, hashing.Default[T]())
+}
+
+// NewHyperLogLogWithHasher returns a new HyperLogLog like NewHyperLogLog, which uses hasher to hash the items.
+// Equal items must have the same hash.
+func NewHyperLogLogWithHasher[T comparable](precision int, hasher func(T) uint64) *HyperLogLog[T] {
+	if precision < MinPrecision || precision > MaxPrecision {
+		precision = DefaultPrecision
+	}
+	return &HyperLogLog[T]{registers: make([]uint8, 1<<precision), p: uint8(precision), hasher: hasher}
+}
+
+// NewHyperLogLogFromSet returns a new HyperLogLog like NewHyperLogLog, with the items of set.
+func NewHyperLogLogFromSet[T comparable](set *sset.Set[T], precision int) *HyperLogLog[T] {
+	var h = NewHyperLogLog[T](precision)
+	set.Iterate(func(item T) bool {
+		h.Add(item)
+		return true
+	})
+	return h
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to HyperLogLog
+func (h *HyperLogLog[T]) Add(data ...T) *HyperLogLog[T] {
+	for _, item := range data {
+		// mix the hash, since HyperLogLog relies on the high bits, which are weak in some hashes like FNV-1a
+		x := hashing.Mix64(h.hasher(item))
+		i := x >> (64 - h.p)
+		// the rank is the position of the first 1 bit in the rest of the hash, at most 64-p+1
+		rank := uint8(bits.LeadingZeros64(x<<h.p|1<<(h.p-1))) + 1
+		if rank > h.registers[i] {
+			h.registers[i] = rank
+		}
+	}
+	return h
+}
+
+// Clone returns a copy of HyperLogLog
+func (h *HyperLogLog[T]) Clone() *HyperLogLog[T] {
+	var newSketch = *h
+	newSketch.registers = make([]uint8, len(h.registers))
+	copy(newSketch.registers, h.registers)
+	return &newSketch
+}
+
+// Clear clears HyperLogLog
+func (h *HyperLogLog[T]) Clear() *HyperLogLog[T] {
+	for i := range h.registers {
+		h.registers[i] = 0
+	}
+	return h
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Merge merges others into HyperLogLog, so that it counts the union of the items.
+// The sketches must have the same precision and hasher, otherwise it returns ErrIncompatible and HyperLogLog is unchanged.
+func (h *HyperLogLog[T]) Merge(others ...*HyperLogLog[T]) error {
+	for _, other := range others {
+		if other.p != h.p {
+			return ErrIncompatible
+		}
+	}
+	for _, other := range others {
+		for i, r := range other.registers {
+			if r > h.registers[i] {
+				h.registers[i] = r
+			}
+		}
+	}
+	return nil
+}
+
+// Count returns the estimated number of distinct items added to HyperLogLog.
+func (h *HyperLogLog[T]) Count() int {
+	var m = float64(len(h.registers))
+	var sum float64
+	var zeros int
+	for _, r := range h.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := alpha(len(h.registers)) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// linear counting is more accurate for small cardinalities
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int(math.Round(estimate))
+}
+
+// Precision returns the precision of HyperLogLog, which has 2^precision registers.
+func (h *HyperLogLog[T]) Precision() int {
+	return int(h.p)
+}
+
+// IsEmpty checks if HyperLogLog is empty
+func (h *HyperLogLog[T]) IsEmpty() bool {
+	for _, r := range h.registers {
+		if r != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The data is an 'H' byte and the precision byte,
+// followed by the registers, one byte each.
+func (h *HyperLogLog[T]) MarshalBinary() ([]byte, error) {
+	var data = make([]byte, 2+len(h.registers))
+	data[0], data[1] = hyperLogLogMagic, h.p
+	copy(data[2:], h.registers)
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the precision and items of HyperLogLog.
+// The hasher is kept, or the default one is used for a zero HyperLogLog.
+func (h *HyperLogLog[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != hyperLogLogMagic {
+		return errors.New("invalid HyperLogLog data: bad magic")
+	}
+	p := data[1]
+	if p < MinPrecision || p > MaxPrecision {
+		return fmt.Errorf("invalid HyperLogLog data: bad precision %d", p)
+	}
+	data = data[2:]
+	if len(data) != 1<<p {
+		return fmt.Errorf("invalid HyperLogLog data: want %d registers, got %d", 1<<p, len(data))
+	}
+	var registers = make([]uint8, len(data))
+	for i, r := range data {
+		if r > 64-p+1 {
+			return fmt.Errorf("invalid HyperLogLog data: bad register %d", r)
+		}
+		registers[i] = r
+	}
+	h.registers, h.p = registers, p
+	if h.hasher == nil {
+		h.hasher = hashing.Default[T]()
+	}
+	return nil
+}
+
+// ---------------------- internal ----------------------
+
+const hyperLogLogMagic = 'H'
+
+// alpha is the bias correction constant of HyperLogLog with m registers.
+func alpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	}
+	return 0.7213 / (1 + 1.079/float64(m))
+}
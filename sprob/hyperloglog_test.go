@@ -0,0 +1,85 @@
+package sprob
+
+import (
+	"github.com/chaseSpace/bear/sset"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestNewHyperLogLog_Precision(t *testing.T) {
+	assert.Equal(t, 10, NewHyperLogLog[int](10).Precision())
+	assert.Equal(t, DefaultPrecision, NewHyperLogLog[int](3).Precision())
+	assert.Equal(t, DefaultPrecision, NewHyperLogLog[int](19).Precision())
+	assert.Len(t, NewHyperLogLog[int](MinPrecision).registers, 16)
+}
+
+func TestHyperLogLog_CountAgainstSet(t *testing.T) {
+	for _, n := range []int{0, 1, 100, 5000, 200000} {
+		exact := sset.New[string]()
+		for i := 0; i < n; i++ {
+			exact.Add("visitor:" + strconv.Itoa(i))
+		}
+		for _, p := range []int{10, 14} {
+			h := NewHyperLogLogFromSet(exact, p)
+			stdErr := 1.04 / math.Sqrt(float64(int(1)<<p))
+			// within 4 standard errors, or 1 for tiny sets
+			assert.InDelta(t, exact.Size(), h.Count(), math.Max(1, 4*stdErr*float64(exact.Size())), "n=%d p=%d", n, p)
+		}
+	}
+}
+
+func TestHyperLogLog_DuplicatesAndClear(t *testing.T) {
+	h := NewHyperLogLog[int](12)
+	assert.True(t, h.IsEmpty())
+	for round := 0; round < 10; round++ {
+		for i := 0; i < 1000; i++ {
+			h.Add(i)
+		}
+	}
+	assert.InEpsilon(t, 1000, h.Count(), 0.05)
+
+	c := h.Clone().Add(-1)
+	assert.NotEqual(t, h.registers, c.registers)
+
+	h.Clear()
+	assert.True(t, h.IsEmpty())
+	assert.Equal(t, 0, h.Count())
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	a, b := sset.New[int](), sset.New[int]()
+	for i := 0; i < 30000; i++ {
+		a.Add(i)
+		b.Add(i + 20000)
+	}
+	ha, hb := NewHyperLogLogFromSet(a, 14), NewHyperLogLogFromSet(b, 14)
+	assert.Nil(t, ha.Merge(hb))
+	union := a.Union(b)
+	assert.InEpsilon(t, union.Size(), ha.Count(), 0.04)
+	assert.Equal(t, NewHyperLogLogFromSet(union, 14).registers, ha.registers)
+
+	before := ha.Clone()
+	assert.Equal(t, ErrIncompatible, ha.Merge(hb, NewHyperLogLog[int](12)))
+	assert.Equal(t, before.registers, ha.registers)
+}
+
+func TestHyperLogLog_Binary(t *testing.T) {
+	h := NewHyperLogLog[string](8).Add("a", "b", "c")
+	data, err := h.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded HyperLogLog[string]
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, 3, decoded.Count())
+	decoded.Add("d")
+	assert.Equal(t, 4, decoded.Count())
+
+	assert.NotNil(t, decoded.UnmarshalBinary(nil))
+	assert.NotNil(t, decoded.UnmarshalBinary([]byte{'H', 30}))
+	assert.NotNil(t, decoded.UnmarshalBinary(data[:len(data)-1]))
+	bad := append([]byte(nil), data...)
+	bad[2] = 60
+	assert.NotNil(t, decoded.UnmarshalBinary(bad))
+}
@@ -0,0 +1,182 @@
+package sprob
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/chaseSpace/bear/internal/hashing"
+	"github.com/chaseSpace/bear/sset"
+	"math"
+)
+
+// MinHash is a signature of a set, which estimates the Jaccard similarity |A∩B| / |A∪B| of two sets
+// from their signatures alone. The signature keeps the least hash of the items under each of size hash
+// functions, so it takes 8*size bytes whatever the number of items, and the standard error of the
+// estimate is about 1/sqrt(size), e.g. 0.09 for the default size 128.
+//
+// The hash functions are fixed, so signatures created with the same size and hasher can be compared,
+// even across processes. The zero MinHash is only useful as the target of UnmarshalBinary.
+type MinHash[T comparable] struct {
+	mins   []uint64
+	hasher func(T) uint64
+}
+
+// DefaultSignatureSize is the size of MinHash if an invalid one is given.
+const DefaultSignatureSize = 128
+
+// NewMinHash returns a new MinHash with size hash functions. If size <= 0, it uses DefaultSignatureSize.
+// It uses the same default hasher as NewBloom. For struct types, NewMinHashWithHasher is faster.
+func NewMinHash[T comparable](size int) *MinHash[T] {
+	return NewMinHashWithHasher(size, hashing.Default[T]())
+}
+
+// NewMinHashWithHasher returns a new MinHash like NewMinHash, which uses hasher to hash the items.
+// Equal items must have the same hash.
+func NewMinHashWithHasher[T comparable](size int, hasher func(T) uint64) *MinHash[T] {
+	if size <= 0 {
+		size = DefaultSignatureSize
+	}
+	var m = &MinHash[T]{mins: make([]uint64, size), hasher: hasher}
+	return m.Clear()
+}
+
+// NewMinHashFromSet returns a new MinHash like NewMinHash, with the items of set.
+func NewMinHashFromSet[T comparable](set *sset.Set[T], size int) *MinHash[T] {
+	var m = NewMinHash[T](size)
+	set.Iterate(func(item T) bool {
+		m.Add(item)
+		return true
+	})
+	return m
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to MinHash
+func (m *MinHash[T]) Add(data ...T) *MinHash[T] {
+	for _, item := range data {
+		h := m.hasher(item)
+		for i := range m.mins {
+			// the i-th hash function mixes the item hash with a distinct constant
+			if v := hashing.Mix64(h ^ (uint64(i)+1)*0x9e3779b97f4a7c15); v < m.mins[i] {
+				m.mins[i] = v
+			}
+		}
+	}
+	return m
+}
+
+// Clone returns a copy of MinHash
+func (m *MinHash[T]) Clone() *MinHash[T] {
+	var newSketch = *m
+	newSketch.mins = make([]uint64, len(m.mins))
+	copy(newSketch.mins, m.mins)
+	return &newSketch
+}
+
+// Clear clears MinHash
+func (m *MinHash[T]) Clear() *MinHash[T] {
+	for i := range m.mins {
+		m.mins[i] = math.MaxUint64
+	}
+	return m
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Similarity returns the estimated Jaccard similarity of the sets of MinHash and other, in [0, 1].
+// It returns 0 if either set is empty.
+// The signatures must have the same size and hasher, otherwise it returns ErrIncompatible.
+func (m *MinHash[T]) Similarity(other *MinHash[T]) (float64, error) {
+	if len(m.mins) != len(other.mins) {
+		return 0, ErrIncompatible
+	}
+	if m.IsEmpty() || other.IsEmpty() {
+		return 0, nil
+	}
+	var equal int
+	for i, v := range m.mins {
+		if v == other.mins[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(m.mins)), nil
+}
+
+// Merge merges others into MinHash, so that it is the signature of the union of the sets.
+// The signatures must have the same size and hasher, otherwise it returns ErrIncompatible and MinHash is unchanged.
+func (m *MinHash[T]) Merge(others ...*MinHash[T]) error {
+	for _, other := range others {
+		if len(other.mins) != len(m.mins) {
+			return ErrIncompatible
+		}
+	}
+	for _, other := range others {
+		for i, v := range other.mins {
+			if v < m.mins[i] {
+				m.mins[i] = v
+			}
+		}
+	}
+	return nil
+}
+
+// Signature returns a copy of the signature of MinHash, which is the least hash under each hash function.
+func (m *MinHash[T]) Signature() []uint64 {
+	var copied = make([]uint64, len(m.mins))
+	copy(copied, m.mins)
+	return copied
+}
+
+// SignatureSize returns the number of hash functions of MinHash.
+func (m *MinHash[T]) SignatureSize() int {
+	return len(m.mins)
+}
+
+// IsEmpty checks if MinHash is empty
+func (m *MinHash[T]) IsEmpty() bool {
+	// an item sets every minimum, so checking one is enough
+	return len(m.mins) == 0 || m.mins[0] == math.MaxUint64
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The data is an 'M' byte and the signature size as uvarint,
+// followed by the signature in little-endian order.
+func (m *MinHash[T]) MarshalBinary() ([]byte, error) {
+	var data = make([]byte, 1+binary.MaxVarintLen64+8*len(m.mins))
+	data[0] = minHashMagic
+	n := 1 + binary.PutUvarint(data[1:], uint64(len(m.mins)))
+	for _, v := range m.mins {
+		binary.LittleEndian.PutUint64(data[n:], v)
+		n += 8
+	}
+	return data[:n], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the signature of MinHash.
+// The hasher is kept, or the default one is used for a zero MinHash.
+func (m *MinHash[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != minHashMagic {
+		return errors.New("invalid MinHash data: bad magic")
+	}
+	size, n := binary.Uvarint(data[1:])
+	if n <= 0 || size == 0 {
+		return errors.New("invalid MinHash data: bad signature size")
+	}
+	data = data[1+n:]
+	if uint64(len(data))%8 != 0 || uint64(len(data))/8 != size {
+		return fmt.Errorf("invalid MinHash data: want %d hashes, got %d bytes", size, len(data))
+	}
+	var mins = make([]uint64, size)
+	for i := range mins {
+		mins[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	m.mins = mins
+	if m.hasher == nil {
+		m.hasher = hashing.Default[T]()
+	}
+	return nil
+}
+
+// ---------------------- internal ----------------------
+
+const minHashMagic = 'M'
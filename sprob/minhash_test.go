@@ -0,0 +1,75 @@
+package sprob
+
+import (
+	"github.com/chaseSpace/bear/sset"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+// jaccard returns the exact Jaccard similarity of a and b.
+func jaccard[T comparable](a, b *sset.Set[T]) float64 {
+	return float64(a.Intersect(b).Size()) / float64(a.Union(b).Size())
+}
+
+func TestMinHash_SimilarityAgainstSet(t *testing.T) {
+	for _, overlap := range []int{0, 250, 500, 900, 1000} {
+		a, b := sset.New[int](), sset.New[int]()
+		for i := 0; i < 1000; i++ {
+			a.Add(i)
+			b.Add(i + 1000 - overlap)
+		}
+		for _, size := range []int{128, 512} {
+			sim, err := NewMinHashFromSet(a, size).Similarity(NewMinHashFromSet(b, size))
+			assert.Nil(t, err)
+			// within 4 standard errors
+			assert.InDelta(t, jaccard(a, b), sim, 4/math.Sqrt(float64(size)), "overlap=%d size=%d", overlap, size)
+		}
+	}
+}
+
+func TestMinHash_IdenticalAndEmpty(t *testing.T) {
+	a := NewMinHash[string](0).Add("x", "y")
+	assert.Equal(t, DefaultSignatureSize, a.SignatureSize())
+	sim, err := a.Similarity(NewMinHash[string](0).Add("y", "x", "x"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, sim)
+
+	empty := NewMinHash[string](0)
+	assert.True(t, empty.IsEmpty())
+	sim, err = a.Similarity(empty)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, sim)
+
+	_, err = a.Similarity(NewMinHash[string](64))
+	assert.Equal(t, ErrIncompatible, err)
+}
+
+func TestMinHash_MergeCloneClear(t *testing.T) {
+	a := NewMinHash[int](64).Add(1, 2)
+	b := NewMinHash[int](64).Add(3)
+	c := a.Clone()
+	assert.Nil(t, c.Merge(b))
+	assert.Equal(t, NewMinHash[int](64).Add(1, 2, 3).Signature(), c.Signature())
+	assert.NotEqual(t, a.Signature(), c.Signature())
+
+	assert.Equal(t, ErrIncompatible, c.Merge(NewMinHash[int](32)))
+	assert.True(t, c.Clear().IsEmpty())
+}
+
+func TestMinHash_Binary(t *testing.T) {
+	m := NewMinHash[string](16).Add("a", "b")
+	data, err := m.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded MinHash[string]
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.Equal(t, m.Signature(), decoded.Signature())
+	sim, err := decoded.Similarity(NewMinHash[string](16).Add("b", "a"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, sim)
+
+	assert.NotNil(t, decoded.UnmarshalBinary(nil))
+	assert.NotNil(t, decoded.UnmarshalBinary([]byte{'M', 0}))
+	assert.NotNil(t, decoded.UnmarshalBinary(data[:len(data)-1]))
+}
@@ -0,0 +1,613 @@
+package sset
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// Bitmap is a compressed set of uint32 values in the way of Roaring bitmaps.
+// It splits the values into chunks by their high 16 bits, and stores each chunk in a container.
+// A sparse chunk uses a sorted array of its low 16 bits, and a dense chunk uses a bitmap of 65536 bits,
+// so Bitmap stays small for both sparse and dense values.
+//
+// The algebra methods work on 64-bit words when both containers are bitmaps.
+type Bitmap struct {
+	keys       []uint16
+	containers []*container
+}
+
+// NewBitmap returns a new Bitmap with items.
+func NewBitmap(items ...uint32) *Bitmap {
+	var b = &Bitmap{}
+	return b.Add(items...)
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to Bitmap
+func (b *Bitmap) Add(data ...uint32) *Bitmap {
+	for _, item := range data {
+		i, ok := b.search(uint16(item >> 16))
+		if !ok {
+			b.keys = append(b.keys, 0)
+			copy(b.keys[i+1:], b.keys[i:])
+			b.keys[i] = uint16(item >> 16)
+			b.containers = append(b.containers, nil)
+			copy(b.containers[i+1:], b.containers[i:])
+			b.containers[i] = &container{}
+		}
+		b.containers[i].add(uint16(item))
+	}
+	return b
+}
+
+// Delete deletes data from Bitmap
+func (b *Bitmap) Delete(data ...uint32) *Bitmap {
+	for _, item := range data {
+		i, ok := b.search(uint16(item >> 16))
+		if !ok {
+			continue
+		}
+		c := b.containers[i]
+		c.remove(uint16(item))
+		if c.n == 0 {
+			b.keys = append(b.keys[:i], b.keys[i+1:]...)
+			b.containers = append(b.containers[:i], b.containers[i+1:]...)
+		}
+	}
+	return b
+}
+
+// Clone returns a copy of Bitmap
+func (b *Bitmap) Clone() *Bitmap {
+	var newSet = &Bitmap{
+		keys:       make([]uint16, len(b.keys)),
+		containers: make([]*container, len(b.containers)),
+	}
+	copy(newSet.keys, b.keys)
+	for i, c := range b.containers {
+		newSet.containers[i] = c.clone()
+	}
+	return newSet
+}
+
+// Clear clears Bitmap
+func (b *Bitmap) Clear() *Bitmap {
+	b.keys, b.containers = nil, nil
+	return b
+}
+
+// Merge merges Bitmap to self with other
+func (b *Bitmap) Merge(other *Bitmap) *Bitmap {
+	*b = *b.combine(other, unionContainers, true, true)
+	return b
+}
+
+// Union returns a new Bitmap which is the union of Bitmap and others
+func (b *Bitmap) Union(others ...*Bitmap) *Bitmap {
+	var newSet = b
+	for _, other := range others {
+		newSet = newSet.combine(other, unionContainers, true, true)
+	}
+	if newSet == b {
+		return b.Clone()
+	}
+	return newSet
+}
+
+// Intersect returns a new Bitmap, which is the intersection of Bitmap and others
+func (b *Bitmap) Intersect(others ...*Bitmap) *Bitmap {
+	var newSet = b
+	for _, other := range others {
+		newSet = newSet.combine(other, intersectContainers, false, false)
+	}
+	if newSet == b {
+		return b.Clone()
+	}
+	return newSet
+}
+
+// IntersectInPlace removes the items that are not in all of others from Bitmap
+func (b *Bitmap) IntersectInPlace(others ...*Bitmap) *Bitmap {
+	*b = *b.Intersect(others...)
+	return b
+}
+
+// Diff returns a new Bitmap which is the difference set from Bitmap to others
+func (b *Bitmap) Diff(others ...*Bitmap) *Bitmap {
+	var newSet = b
+	for _, other := range others {
+		newSet = newSet.combine(other, diffContainers, true, false)
+	}
+	if newSet == b {
+		return b.Clone()
+	}
+	return newSet
+}
+
+// DiffInPlace removes the items in any of others from Bitmap
+func (b *Bitmap) DiffInPlace(others ...*Bitmap) *Bitmap {
+	*b = *b.Diff(others...)
+	return b
+}
+
+// SymmetricDiff returns a new Bitmap which has the items that are in exactly one of Bitmap and other
+func (b *Bitmap) SymmetricDiff(other *Bitmap) *Bitmap {
+	return b.combine(other, xorContainers, true, true)
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Has checks if Bitmap contains item
+func (b *Bitmap) Has(item uint32) bool {
+	i, ok := b.search(uint16(item >> 16))
+	return ok && b.containers[i].has(uint16(item))
+}
+
+// Cardinality returns the number of items in Bitmap
+func (b *Bitmap) Cardinality() int {
+	var n int
+	for _, c := range b.containers {
+		n += c.n
+	}
+	return n
+}
+
+// NextSet returns the least item that is greater than or equal to i. The bool is false if there is no such item.
+func (b *Bitmap) NextSet(i uint32) (uint32, bool) {
+	key := uint16(i >> 16)
+	k, ok := b.search(key)
+	if ok {
+		if low, found := b.containers[k].next(uint16(i)); found {
+			return uint32(key)<<16 | uint32(low), true
+		}
+		k++
+	}
+	if k < len(b.keys) {
+		low, _ := b.containers[k].next(0)
+		return uint32(b.keys[k])<<16 | uint32(low), true
+	}
+	return 0, false
+}
+
+// IsSubsetOf checks if Bitmap is a subset of other
+func (b *Bitmap) IsSubsetOf(other *Bitmap) bool {
+	for i, key := range b.keys {
+		j, ok := other.search(key)
+		if !ok || !b.containers[i].isSubsetOf(other.containers[j]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal checks if Bitmap is equal to other
+func (b *Bitmap) Equal(other *Bitmap) bool {
+	return len(b.keys) == len(other.keys) && b.Cardinality() == other.Cardinality() && b.IsSubsetOf(other)
+}
+
+// IsEmpty checks if Bitmap is empty
+func (b *Bitmap) IsEmpty() bool {
+	return len(b.keys) == 0
+}
+
+// Slice returns the items in Bitmap in ascending order
+func (b *Bitmap) Slice() []uint32 {
+	var copied = make([]uint32, 0, b.Cardinality())
+	b.Iterate(func(item uint32) bool {
+		copied = append(copied, item)
+		return true
+	})
+	return copied
+}
+
+// Join joins Bitmap by sep in ascending order
+func (b *Bitmap) Join(sep string) string {
+	var ss []string
+	b.Iterate(func(item uint32) bool {
+		ss = append(ss, fmt.Sprint(item))
+		return true
+	})
+	return strings.Join(ss, sep)
+}
+
+// Iterate calls f for each item in Bitmap in ascending order. If f returns false, Iterate stops the iteration.
+func (b *Bitmap) Iterate(f func(uint32) bool) {
+	for i, c := range b.containers {
+		high := uint32(b.keys[i]) << 16
+		if !c.iterate(func(low uint16) bool { return f(high | uint32(low)) }) {
+			return
+		}
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The data is the number of containers as uvarint,
+// followed by each container: its key as 2 bytes, then an array container as the number of values
+// as uvarint and 2 bytes per value, or a bitmap container as 1024 64-bit words.
+// All fixed-size integers are in little-endian order.
+func (b *Bitmap) MarshalBinary() ([]byte, error) {
+	var buf [binary.MaxVarintLen64]byte
+	var data = make([]byte, 0, binary.MaxVarintLen64+8*b.Cardinality()/4)
+	data = append(data, buf[:binary.PutUvarint(buf[:], uint64(len(b.keys)))]...)
+	for i, c := range b.containers {
+		data = append(data, byte(b.keys[i]), byte(b.keys[i]>>8))
+		if c.bits != nil {
+			data = append(data, containerBitmap)
+			for _, w := range c.bits {
+				binary.LittleEndian.PutUint64(buf[:], w)
+				data = append(data, buf[:8]...)
+			}
+			continue
+		}
+		data = append(data, containerArray)
+		data = append(data, buf[:binary.PutUvarint(buf[:], uint64(len(c.array)))]...)
+		for _, v := range c.array {
+			data = append(data, byte(v), byte(v>>8))
+		}
+	}
+	return data, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the items in Bitmap.
+func (b *Bitmap) UnmarshalBinary(data []byte) error {
+	n, size := binary.Uvarint(data)
+	if size <= 0 || n > 1<<16 {
+		return errors.New("invalid Bitmap data: bad container count")
+	}
+	data = data[size:]
+	var newSet = &Bitmap{keys: make([]uint16, 0, n), containers: make([]*container, 0, n)}
+	for i := uint64(0); i < n; i++ {
+		if len(data) < 3 {
+			return errors.New("invalid Bitmap data: unexpected end")
+		}
+		key := binary.LittleEndian.Uint16(data)
+		if i > 0 && key <= newSet.keys[i-1] {
+			return errors.New("invalid Bitmap data: keys are not ascending")
+		}
+		kind := data[2]
+		data = data[3:]
+
+		var c *container
+		switch kind {
+		case containerArray:
+			count, size := binary.Uvarint(data)
+			if size <= 0 || count == 0 || count > arrayMaxSize || uint64(len(data)-size) < 2*count {
+				return errors.New("invalid Bitmap data: bad array container")
+			}
+			data = data[size:]
+			c = &container{array: make([]uint16, count), n: int(count)}
+			for j := range c.array {
+				c.array[j] = binary.LittleEndian.Uint16(data[2*j:])
+				if j > 0 && c.array[j] <= c.array[j-1] {
+					return errors.New("invalid Bitmap data: array values are not ascending")
+				}
+			}
+			data = data[2*count:]
+		case containerBitmap:
+			if len(data) < 8*bitmapWords {
+				return errors.New("invalid Bitmap data: bad bitmap container")
+			}
+			var words = make([]uint64, bitmapWords)
+			for j := range words {
+				words[j] = binary.LittleEndian.Uint64(data[8*j:])
+			}
+			data = data[8*bitmapWords:]
+			if c = containerFromWords(words); c == nil {
+				return errors.New("invalid Bitmap data: empty bitmap container")
+			}
+		default:
+			return fmt.Errorf("invalid Bitmap data: unknown container kind %d", kind)
+		}
+		newSet.keys = append(newSet.keys, key)
+		newSet.containers = append(newSet.containers, c)
+	}
+	if len(data) != 0 {
+		return fmt.Errorf("invalid Bitmap data: %d trailing bytes", len(data))
+	}
+	*b = *newSet
+	return nil
+}
+
+// ---------------------- internal ----------------------
+
+const (
+	// arrayMaxSize is the max cardinality of an array container, above which a bitmap container is smaller.
+	arrayMaxSize = 4096
+	bitmapWords  = 1 << 16 / 64
+
+	containerArray  byte = 0
+	containerBitmap byte = 1
+)
+
+// search returns the index of key in b.keys, or where it would be inserted.
+func (b *Bitmap) search(key uint16) (int, bool) {
+	i := sort.Search(len(b.keys), func(i int) bool { return b.keys[i] >= key })
+	return i, i < len(b.keys) && b.keys[i] == key
+}
+
+// combine returns a new Bitmap by merging the keys of b and other, and combining the containers with op.
+// keepLeft and keepRight tell whether a container with a key only in b or only in other goes to the result.
+func (b *Bitmap) combine(other *Bitmap, op func(a, c *container) *container, keepLeft, keepRight bool) *Bitmap {
+	var newSet = &Bitmap{}
+	var push = func(key uint16, c *container) {
+		if c != nil {
+			newSet.keys = append(newSet.keys, key)
+			newSet.containers = append(newSet.containers, c)
+		}
+	}
+	var i, j int
+	for i < len(b.keys) && j < len(other.keys) {
+		switch {
+		case b.keys[i] < other.keys[j]:
+			if keepLeft {
+				push(b.keys[i], b.containers[i].clone())
+			}
+			i++
+		case b.keys[i] > other.keys[j]:
+			if keepRight {
+				push(other.keys[j], other.containers[j].clone())
+			}
+			j++
+		default:
+			push(b.keys[i], op(b.containers[i], other.containers[j]))
+			i++
+			j++
+		}
+	}
+	for ; keepLeft && i < len(b.keys); i++ {
+		push(b.keys[i], b.containers[i].clone())
+	}
+	for ; keepRight && j < len(other.keys); j++ {
+		push(other.keys[j], other.containers[j].clone())
+	}
+	return newSet
+}
+
+// container holds the low 16 bits of the values in a chunk of Bitmap.
+// It uses the sorted array if bits is nil, or else the bitmap in bits.
+type container struct {
+	array []uint16
+	bits  []uint64
+	n     int
+}
+
+func (c *container) clone() *container {
+	var newC = &container{n: c.n}
+	if c.bits != nil {
+		newC.bits = make([]uint64, bitmapWords)
+		copy(newC.bits, c.bits)
+	} else {
+		newC.array = make([]uint16, len(c.array))
+		copy(newC.array, c.array)
+	}
+	return newC
+}
+
+func (c *container) search(v uint16) (int, bool) {
+	i := sort.Search(len(c.array), func(i int) bool { return c.array[i] >= v })
+	return i, i < len(c.array) && c.array[i] == v
+}
+
+func (c *container) has(v uint16) bool {
+	if c.bits != nil {
+		return c.bits[v>>6]&(1<<(v&63)) != 0
+	}
+	_, ok := c.search(v)
+	return ok
+}
+
+func (c *container) add(v uint16) {
+	if c.bits != nil {
+		if c.bits[v>>6]&(1<<(v&63)) == 0 {
+			c.bits[v>>6] |= 1 << (v & 63)
+			c.n++
+		}
+		return
+	}
+	i, ok := c.search(v)
+	if ok {
+		return
+	}
+	if len(c.array) == arrayMaxSize {
+		c.bits, c.array = c.words(), nil
+		c.add(v)
+		return
+	}
+	c.array = append(c.array, 0)
+	copy(c.array[i+1:], c.array[i:])
+	c.array[i] = v
+	c.n++
+}
+
+func (c *container) remove(v uint16) {
+	if c.bits != nil {
+		if c.bits[v>>6]&(1<<(v&63)) != 0 {
+			c.bits[v>>6] &^= 1 << (v & 63)
+			c.n--
+			if c.n <= arrayMaxSize {
+				c.array, c.bits = wordsToArray(c.bits, c.n), nil
+			}
+		}
+		return
+	}
+	if i, ok := c.search(v); ok {
+		c.array = append(c.array[:i], c.array[i+1:]...)
+		c.n--
+	}
+}
+
+// words returns the container as a bitmap. It returns c.bits itself for a bitmap container.
+func (c *container) words() []uint64 {
+	if c.bits != nil {
+		return c.bits
+	}
+	var words = make([]uint64, bitmapWords)
+	for _, v := range c.array {
+		words[v>>6] |= 1 << (v & 63)
+	}
+	return words
+}
+
+// next returns the least value in the container that is greater than or equal to v.
+func (c *container) next(v uint16) (uint16, bool) {
+	if c.bits == nil {
+		i, _ := c.search(v)
+		if i < len(c.array) {
+			return c.array[i], true
+		}
+		return 0, false
+	}
+	w := int(v >> 6)
+	if word := c.bits[w] >> (v & 63); word != 0 {
+		return v + uint16(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < bitmapWords; w++ {
+		if c.bits[w] != 0 {
+			return uint16(w<<6 + bits.TrailingZeros64(c.bits[w])), true
+		}
+	}
+	return 0, false
+}
+
+// iterate calls f for each value in ascending order. It returns false if f stops the iteration.
+func (c *container) iterate(f func(uint16) bool) bool {
+	if c.bits == nil {
+		for _, v := range c.array {
+			if !f(v) {
+				return false
+			}
+		}
+		return true
+	}
+	for i, w := range c.bits {
+		for w != 0 {
+			if !f(uint16(i<<6 + bits.TrailingZeros64(w))) {
+				return false
+			}
+			w &= w - 1
+		}
+	}
+	return true
+}
+
+func (c *container) isSubsetOf(other *container) bool {
+	if c.n > other.n {
+		return false
+	}
+	if c.bits != nil {
+		// other must be a bitmap as well, since it is not smaller.
+		for i, w := range c.bits {
+			if w&^other.bits[i] != 0 {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range c.array {
+		if !other.has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// filter returns a new container of the values in array container c that match f, or nil if there are none.
+func (c *container) filter(f func(uint16) bool) *container {
+	var array []uint16
+	for _, v := range c.array {
+		if f(v) {
+			array = append(array, v)
+		}
+	}
+	if len(array) == 0 {
+		return nil
+	}
+	return &container{array: array, n: len(array)}
+}
+
+func unionContainers(a, b *container) *container {
+	if a.bits == nil && b.bits == nil && a.n+b.n <= arrayMaxSize {
+		var array = make([]uint16, 0, a.n+b.n)
+		var i, j int
+		for i < len(a.array) && j < len(b.array) {
+			switch {
+			case a.array[i] < b.array[j]:
+				array = append(array, a.array[i])
+				i++
+			case a.array[i] > b.array[j]:
+				array = append(array, b.array[j])
+				j++
+			default:
+				array = append(array, a.array[i])
+				i++
+				j++
+			}
+		}
+		array = append(array, a.array[i:]...)
+		array = append(array, b.array[j:]...)
+		return &container{array: array, n: len(array)}
+	}
+	return combineWords(a, b, func(x, y uint64) uint64 { return x | y })
+}
+
+func intersectContainers(a, b *container) *container {
+	switch {
+	case a.bits == nil:
+		return a.filter(b.has)
+	case b.bits == nil:
+		return b.filter(a.has)
+	}
+	return combineWords(a, b, func(x, y uint64) uint64 { return x & y })
+}
+
+func diffContainers(a, b *container) *container {
+	if a.bits == nil {
+		return a.filter(func(v uint16) bool { return !b.has(v) })
+	}
+	return combineWords(a, b, func(x, y uint64) uint64 { return x &^ y })
+}
+
+func xorContainers(a, b *container) *container {
+	return combineWords(a, b, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// combineWords combines a and b word by word with op, and returns the result as a container of the proper kind.
+func combineWords(a, b *container, op func(x, y uint64) uint64) *container {
+	var x, y = a.words(), b.words()
+	var words = make([]uint64, bitmapWords)
+	for i := range words {
+		words[i] = op(x[i], y[i])
+	}
+	return containerFromWords(words)
+}
+
+// containerFromWords returns a container of the bits in words, or nil if there are none.
+// It takes the ownership of words.
+func containerFromWords(words []uint64) *container {
+	var n int
+	for _, w := range words {
+		n += bits.OnesCount64(w)
+	}
+	switch {
+	case n == 0:
+		return nil
+	case n <= arrayMaxSize:
+		return &container{array: wordsToArray(words, n), n: n}
+	}
+	return &container{bits: words, n: n}
+}
+
+func wordsToArray(words []uint64, n int) []uint16 {
+	var array = make([]uint16, 0, n)
+	for i, w := range words {
+		for w != 0 {
+			array = append(array, uint16(i<<6+bits.TrailingZeros64(w)))
+			w &= w - 1
+		}
+	}
+	return array
+}
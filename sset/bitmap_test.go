@@ -0,0 +1,186 @@
+package sset
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestBitmap_AddDeleteHas(t *testing.T) {
+	b := NewBitmap(1, 70000, 1<<31)
+	assert.True(t, b.Has(1))
+	assert.True(t, b.Has(70000))
+	assert.True(t, b.Has(1<<31))
+	assert.False(t, b.Has(2))
+	assert.Equal(t, 3, b.Cardinality())
+	assert.Equal(t, []uint32{1, 70000, 1 << 31}, b.Slice())
+	assert.Equal(t, "1,70000,2147483648", b.Join(","))
+
+	b.Delete(70000, 5)
+	assert.Equal(t, []uint32{1, 1 << 31}, b.Slice())
+	assert.Equal(t, 2, len(b.keys))
+
+	b.Clear()
+	assert.True(t, b.IsEmpty())
+
+	var zero Bitmap
+	zero.Add(9)
+	assert.True(t, zero.Has(9))
+}
+
+func TestBitmap_ContainerConversion(t *testing.T) {
+	b := NewBitmap()
+	for i := uint32(0); i < arrayMaxSize; i++ {
+		b.Add(2 * i)
+	}
+	assert.Nil(t, b.containers[0].bits)
+
+	b.Add(1)
+	assert.NotNil(t, b.containers[0].bits)
+	assert.Equal(t, arrayMaxSize+1, b.Cardinality())
+	assert.True(t, b.Has(1))
+	assert.True(t, b.Has(8190))
+	assert.False(t, b.Has(3))
+
+	b.Delete(1)
+	assert.Nil(t, b.containers[0].bits)
+	assert.Equal(t, arrayMaxSize, b.Cardinality())
+	assert.False(t, b.Has(1))
+}
+
+func TestBitmap_Algebra(t *testing.T) {
+	a := NewBitmap(1, 2, 3, 1<<20)
+	b := NewBitmap(2, 3, 4, 1<<25)
+
+	assert.Equal(t, []uint32{1, 2, 3, 4, 1 << 20, 1 << 25}, a.Union(b).Slice())
+	assert.Equal(t, []uint32{2, 3}, a.Intersect(b).Slice())
+	assert.Equal(t, []uint32{1, 1 << 20}, a.Diff(b).Slice())
+	assert.Equal(t, []uint32{1, 4, 1 << 20, 1 << 25}, a.SymmetricDiff(b).Slice())
+	assert.Equal(t, []uint32{2}, a.Intersect(b, NewBitmap(2)).Slice())
+	assert.Equal(t, []uint32{1 << 20}, a.Diff(b, NewBitmap(1)).Slice())
+
+	// the operands are unchanged
+	assert.Equal(t, []uint32{1, 2, 3, 1 << 20}, a.Slice())
+	assert.Equal(t, []uint32{2, 3, 4, 1 << 25}, b.Slice())
+
+	// results do not share containers with the operands
+	u := a.Union()
+	u.Add(5)
+	assert.False(t, a.Has(5))
+
+	assert.Equal(t, []uint32{2, 3}, a.Clone().IntersectInPlace(b).Slice())
+	assert.Equal(t, []uint32{1, 1 << 20}, a.Clone().DiffInPlace(b).Slice())
+	assert.True(t, a.Clone().Merge(b).Equal(a.Union(b)))
+
+	assert.True(t, NewBitmap(2, 1<<20).IsSubsetOf(a))
+	assert.False(t, a.IsSubsetOf(b))
+	assert.True(t, a.Equal(a.Clone()))
+	assert.False(t, a.Equal(b))
+}
+
+func TestBitmap_NextSet(t *testing.T) {
+	b := NewBitmap(5, 65535, 1<<16, 1<<30)
+	var got []uint32
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		got = append(got, i)
+	}
+	assert.Equal(t, []uint32{5, 65535, 1 << 16, 1 << 30}, got)
+
+	i, ok := b.NextSet(1<<16 + 1)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(1<<30), i)
+	_, ok = b.NextSet(1<<30 + 1)
+	assert.False(t, ok)
+}
+
+// TestBitmap_Random compares Bitmap with BitSet on random data with both sparse and dense containers.
+func TestBitmap_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := func() (*Bitmap, *BitSet) {
+		b, s := NewBitmap(), NewBitSet()
+		// a dense chunk, a sparse chunk and a chunk that is either
+		for _, spec := range []struct{ base, n int }{{0, 30000}, {1 << 16, 100}, {3 << 16, r.Intn(8000)}} {
+			for i := 0; i < spec.n; i++ {
+				v := uint32(spec.base + r.Intn(1<<16))
+				b.Add(v)
+				s.Add(uint(v))
+			}
+		}
+		return b, s
+	}
+	check := func(b *Bitmap, s *BitSet) {
+		want := s.Slice()
+		got := b.Slice()
+		assert.Equal(t, len(want), len(got))
+		assert.True(t, sort.SliceIsSorted(got, func(i, j int) bool { return got[i] < got[j] }))
+		for i := range want {
+			if uint(got[i]) != want[i] {
+				t.Fatalf("item %d: want %d, got %d", i, want[i], got[i])
+			}
+		}
+		for _, c := range b.containers {
+			assert.Equal(t, c.bits != nil, c.n > arrayMaxSize)
+		}
+	}
+
+	for round := 0; round < 5; round++ {
+		a, sa := gen()
+		b, sb := gen()
+		check(a, sa)
+		check(a.Union(b), sa.Union(sb))
+		check(a.Intersect(b), sa.Intersect(sb))
+		check(a.Diff(b), sa.Diff(sb))
+		check(b.Diff(a), sb.Diff(sa))
+		check(a.SymmetricDiff(b), sa.SymmetricDiff(sb))
+		assert.True(t, a.Intersect(b).IsSubsetOf(a))
+
+		for i := 0; i < 20000; i++ {
+			v := uint32(r.Intn(4 << 16))
+			a.Delete(v)
+			sa.Delete(uint(v))
+		}
+		check(a, sa)
+	}
+}
+
+func TestBitmap_Binary(t *testing.T) {
+	b := NewBitmap(1, 1<<20, 1<<31)
+	for i := uint32(0); i < 5000; i++ {
+		b.Add(3<<16 + i)
+	}
+	data, err := b.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded Bitmap
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.True(t, decoded.Equal(b))
+	assert.Equal(t, b.Slice(), decoded.Slice())
+
+	data, err = NewBitmap().MarshalBinary()
+	assert.Nil(t, err)
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.True(t, decoded.IsEmpty())
+
+	assert.NotNil(t, decoded.UnmarshalBinary(nil))
+	assert.NotNil(t, decoded.UnmarshalBinary([]byte{1, 0, 0, 9}))
+	// array values must be ascending
+	assert.NotNil(t, decoded.UnmarshalBinary([]byte{1, 0, 0, 0, 2, 5, 0, 1, 0}))
+	// trailing bytes
+	data, _ = NewBitmap(1).MarshalBinary()
+	assert.NotNil(t, decoded.UnmarshalBinary(append(data, 0)))
+}
+
+func BenchmarkBitmap_Intersect(b *testing.B) {
+	x, y := NewBitmap(), NewBitmap()
+	for i := uint32(0); i < 1<<20; i += 3 {
+		x.Add(i)
+	}
+	for i := uint32(0); i < 1<<20; i += 5 {
+		y.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Intersect(y)
+	}
+}
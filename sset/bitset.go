@@ -0,0 +1,285 @@
+package sset
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// BitSet is a set of non-negative integers, which stores each integer as one bit.
+// It suits dense ranges of integers like IDs, where it uses about 1 bit per possible value,
+// instead of tens of bytes per item in a map. For sparse integers, use Bitmap.
+//
+// The algebra methods work on 64-bit words at once.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet returns a new BitSet with items.
+func NewBitSet(items ...uint) *BitSet {
+	var s = &BitSet{}
+	return s.Add(items...)
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to BitSet, growing it as needed.
+func (s *BitSet) Add(data ...uint) *BitSet {
+	for _, item := range data {
+		i := int(item >> 6)
+		if i >= len(s.words) {
+			s.grow(i + 1)
+		}
+		s.words[i] |= 1 << (item & 63)
+	}
+	return s
+}
+
+// Delete deletes data from BitSet
+func (s *BitSet) Delete(data ...uint) *BitSet {
+	for _, item := range data {
+		if i := int(item >> 6); i < len(s.words) {
+			s.words[i] &^= 1 << (item & 63)
+		}
+	}
+	return s
+}
+
+// Clone returns a copy of BitSet
+func (s *BitSet) Clone() *BitSet {
+	var words = make([]uint64, len(s.words))
+	copy(words, s.words)
+	return &BitSet{words: words}
+}
+
+// Clear clears BitSet
+func (s *BitSet) Clear() *BitSet {
+	s.words = nil
+	return s
+}
+
+// Merge merges BitSet to self with other
+func (s *BitSet) Merge(other *BitSet) *BitSet {
+	if len(other.words) > len(s.words) {
+		s.grow(len(other.words))
+	}
+	for i, w := range other.words {
+		s.words[i] |= w
+	}
+	return s
+}
+
+// Union returns a new BitSet which is the union of BitSet and others
+func (s *BitSet) Union(others ...*BitSet) *BitSet {
+	var newSet = s.Clone()
+	for _, other := range others {
+		newSet.Merge(other)
+	}
+	return newSet
+}
+
+// Intersect returns a new BitSet, which is the intersection of BitSet and others
+func (s *BitSet) Intersect(others ...*BitSet) *BitSet {
+	return s.Clone().IntersectInPlace(others...)
+}
+
+// IntersectInPlace removes the items that are not in all of others from BitSet
+func (s *BitSet) IntersectInPlace(others ...*BitSet) *BitSet {
+	for _, other := range others {
+		if len(other.words) < len(s.words) {
+			// clear the dropped words, so that grow does not bring them back
+			for i := len(other.words); i < len(s.words); i++ {
+				s.words[i] = 0
+			}
+			s.words = s.words[:len(other.words)]
+		}
+		for i := range s.words {
+			s.words[i] &= other.words[i]
+		}
+	}
+	s.trim()
+	return s
+}
+
+// Diff returns a new BitSet which is the difference set from BitSet to others
+func (s *BitSet) Diff(others ...*BitSet) *BitSet {
+	return s.Clone().DiffInPlace(others...)
+}
+
+// DiffInPlace removes the items in any of others from BitSet
+func (s *BitSet) DiffInPlace(others ...*BitSet) *BitSet {
+	for _, other := range others {
+		for i := 0; i < len(s.words) && i < len(other.words); i++ {
+			s.words[i] &^= other.words[i]
+		}
+	}
+	s.trim()
+	return s
+}
+
+// SymmetricDiff returns a new BitSet which has the items that are in exactly one of BitSet and other
+func (s *BitSet) SymmetricDiff(other *BitSet) *BitSet {
+	var newSet = s.Clone()
+	if len(other.words) > len(newSet.words) {
+		newSet.grow(len(other.words))
+	}
+	for i, w := range other.words {
+		newSet.words[i] ^= w
+	}
+	newSet.trim()
+	return newSet
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Has checks if BitSet contains item
+func (s *BitSet) Has(item uint) bool {
+	i := int(item >> 6)
+	return i < len(s.words) && s.words[i]&(1<<(item&63)) != 0
+}
+
+// Cardinality returns the number of items in BitSet
+func (s *BitSet) Cardinality() int {
+	var n int
+	for _, w := range s.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// NextSet returns the least item that is greater than or equal to i. The bool is false if there is no such item.
+func (s *BitSet) NextSet(i uint) (uint, bool) {
+	w := int(i >> 6)
+	if w >= len(s.words) {
+		return 0, false
+	}
+	if word := s.words[w] >> (i & 63); word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < len(s.words); w++ {
+		if s.words[w] != 0 {
+			return uint(w)<<6 + uint(bits.TrailingZeros64(s.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+// IsSubsetOf checks if BitSet is a subset of other
+func (s *BitSet) IsSubsetOf(other *BitSet) bool {
+	for i, w := range s.words {
+		var o uint64
+		if i < len(other.words) {
+			o = other.words[i]
+		}
+		if w&^o != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal checks if BitSet is equal to other
+func (s *BitSet) Equal(other *BitSet) bool {
+	return s.IsSubsetOf(other) && other.IsSubsetOf(s)
+}
+
+// IsEmpty checks if BitSet is empty
+func (s *BitSet) IsEmpty() bool {
+	for _, w := range s.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Slice returns the items in BitSet in ascending order
+func (s *BitSet) Slice() (copied []uint) {
+	s.Iterate(func(item uint) bool {
+		copied = append(copied, item)
+		return true
+	})
+	return copied
+}
+
+// Join joins BitSet by sep in ascending order
+func (s *BitSet) Join(sep string) string {
+	var ss []string
+	s.Iterate(func(item uint) bool {
+		ss = append(ss, fmt.Sprint(item))
+		return true
+	})
+	return strings.Join(ss, sep)
+}
+
+// Iterate calls f for each item in BitSet in ascending order. If f returns false, Iterate stops the iteration.
+func (s *BitSet) Iterate(f func(uint) bool) {
+	for i, w := range s.words {
+		for w != 0 {
+			t := bits.TrailingZeros64(w)
+			if !f(uint(i)<<6 + uint(t)) {
+				return
+			}
+			w &= w - 1
+		}
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler. The data is the number of 64-bit words as uvarint,
+// followed by the words in little-endian order.
+func (s *BitSet) MarshalBinary() ([]byte, error) {
+	var words = s.trimmed()
+	var data = make([]byte, binary.MaxVarintLen64+8*len(words))
+	n := binary.PutUvarint(data, uint64(len(words)))
+	for _, w := range words {
+		binary.LittleEndian.PutUint64(data[n:], w)
+		n += 8
+	}
+	return data[:n], nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It replaces the items in BitSet.
+func (s *BitSet) UnmarshalBinary(data []byte) error {
+	n, size := binary.Uvarint(data)
+	if size <= 0 {
+		return errors.New("invalid BitSet data: bad word count")
+	}
+	data = data[size:]
+	if uint64(len(data))%8 != 0 || uint64(len(data))/8 != n { // divide, as 8*n can overflow
+		return fmt.Errorf("invalid BitSet data: want %d words, got %d bytes", n, len(data))
+	}
+	var words = make([]uint64, n)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[8*i:])
+	}
+	s.words = words
+	s.trim()
+	return nil
+}
+
+// ---------------------- internal ----------------------
+
+func (s *BitSet) grow(n int) {
+	if n <= cap(s.words) {
+		s.words = s.words[:n]
+		return
+	}
+	var words = make([]uint64, n, n+n/4)
+	copy(words, s.words)
+	s.words = words
+}
+
+// trim removes the trailing zero words.
+func (s *BitSet) trim() {
+	s.words = s.trimmed()
+}
+
+func (s *BitSet) trimmed() []uint64 {
+	var n = len(s.words)
+	for n > 0 && s.words[n-1] == 0 {
+		n--
+	}
+	return s.words[:n]
+}
@@ -0,0 +1,117 @@
+package sset
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBitSet_AddDeleteHas(t *testing.T) {
+	s := NewBitSet(1, 64, 200)
+	assert.True(t, s.Has(1))
+	assert.True(t, s.Has(64))
+	assert.True(t, s.Has(200))
+	assert.False(t, s.Has(2))
+	assert.False(t, s.Has(100000))
+	assert.Equal(t, 3, s.Cardinality())
+
+	s.Delete(64, 100000)
+	assert.False(t, s.Has(64))
+	assert.Equal(t, []uint{1, 200}, s.Slice())
+	assert.Equal(t, "1,200", s.Join(","))
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 0, s.Cardinality())
+
+	var zero BitSet
+	assert.False(t, zero.Has(0))
+	zero.Add(3)
+	assert.True(t, zero.Has(3))
+}
+
+func TestBitSet_Algebra(t *testing.T) {
+	a := NewBitSet(1, 2, 3, 130)
+	b := NewBitSet(2, 3, 4, 500)
+
+	assert.Equal(t, []uint{1, 2, 3, 4, 130, 500}, a.Union(b).Slice())
+	assert.Equal(t, []uint{2, 3}, a.Intersect(b).Slice())
+	assert.Equal(t, []uint{1, 130}, a.Diff(b).Slice())
+	assert.Equal(t, []uint{1, 4, 130, 500}, a.SymmetricDiff(b).Slice())
+	assert.Equal(t, []uint{2}, a.Intersect(b, NewBitSet(2, 130)).Slice())
+	assert.Equal(t, []uint{130}, a.Diff(b, NewBitSet(1)).Slice())
+
+	// the operands are unchanged
+	assert.Equal(t, []uint{1, 2, 3, 130}, a.Slice())
+	assert.Equal(t, []uint{2, 3, 4, 500}, b.Slice())
+
+	c := a.Clone().IntersectInPlace(b)
+	assert.Equal(t, []uint{2, 3}, c.Slice())
+	c = a.Clone().DiffInPlace(b)
+	assert.Equal(t, []uint{1, 130}, c.Slice())
+	c = a.Clone().Merge(b)
+	assert.True(t, c.Equal(a.Union(b)))
+
+	// the words dropped by the intersection do not come back when BitSet grows again
+	c = NewBitSet(1000).IntersectInPlace(NewBitSet(1))
+	assert.True(t, c.IsEmpty())
+	c.Add(999)
+	assert.Equal(t, []uint{999}, c.Slice())
+	assert.False(t, c.Has(1000))
+}
+
+func TestBitSet_Predicates(t *testing.T) {
+	a := NewBitSet(1, 300)
+	b := NewBitSet(1, 2, 300)
+	assert.True(t, a.IsSubsetOf(b))
+	assert.False(t, b.IsSubsetOf(a))
+	assert.True(t, NewBitSet().IsSubsetOf(a))
+	assert.False(t, a.Equal(b))
+	// trailing zero words do not matter
+	assert.True(t, a.Equal(NewBitSet(1, 300, 9000).Delete(9000)))
+}
+
+func TestBitSet_NextSet(t *testing.T) {
+	s := NewBitSet(0, 63, 64, 1000)
+	var got []uint
+	for i, ok := s.NextSet(0); ok; i, ok = s.NextSet(i + 1) {
+		got = append(got, i)
+	}
+	assert.Equal(t, []uint{0, 63, 64, 1000}, got)
+
+	i, ok := s.NextSet(65)
+	assert.True(t, ok)
+	assert.Equal(t, uint(1000), i)
+	_, ok = s.NextSet(1001)
+	assert.False(t, ok)
+	_, ok = NewBitSet().NextSet(0)
+	assert.False(t, ok)
+}
+
+func TestBitSet_Iterate_Stop(t *testing.T) {
+	var got []uint
+	NewBitSet(1, 2, 3).Iterate(func(i uint) bool {
+		got = append(got, i)
+		return i < 2
+	})
+	assert.Equal(t, []uint{1, 2}, got)
+}
+
+func TestBitSet_Binary(t *testing.T) {
+	s := NewBitSet(0, 7, 64, 4095)
+	data, err := s.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded BitSet
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.True(t, decoded.Equal(s))
+
+	data, err = NewBitSet().MarshalBinary()
+	assert.Nil(t, err)
+	assert.Nil(t, decoded.UnmarshalBinary(data))
+	assert.True(t, decoded.IsEmpty())
+
+	assert.NotNil(t, decoded.UnmarshalBinary(nil))
+	assert.NotNil(t, decoded.UnmarshalBinary([]byte{2, 1, 2, 3}))
+	// a word count of 2^61, for which 8*n overflows to 0
+	assert.NotNil(t, decoded.UnmarshalBinary([]byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x20}))
+}
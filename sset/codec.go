@@ -0,0 +1,225 @@
+package sset
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"reflect"
+	"sort"
+)
+
+// A set is encoded as a plain list of its items. If T is an ordered type (a string, integer or float type,
+// including named types of them), the items are sorted in ascending order, so the output is deterministic.
+// Otherwise, the order is unspecified.
+
+// MarshalJSON implements json.Marshaler.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.sortedSlice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the items in Set.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	s.reset(items)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s *Set[T]) MarshalYAML() (interface{}, error) {
+	return s.sortedSlice(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the items in Set.
+func (s *Set[T]) UnmarshalYAML(value *yaml.Node) error {
+	var items []T
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	s.reset(items)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of Set.
+func (s *Set[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of Set.
+func (s *Set[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+func (s *Set[T]) reset(items []T) {
+	s.data = make(map[T]struct{}, len(items))
+	for _, item := range items {
+		s.data[item] = struct{}{}
+	}
+}
+
+// sortedSlice returns the items in Set, which are sorted if T is ordered.
+func (s *Set[T]) sortedSlice() []T {
+	var items = make([]T, 0, len(s.data))
+	for k := range s.data {
+		items = append(items, k)
+	}
+	if less := orderedLess[T](); less != nil {
+		sort.Slice(items, func(i, j int) bool {
+			return less(items[i], items[j])
+		})
+	}
+	return items
+}
+
+// orderedLess returns a less function for T if T is an ordered type, otherwise nil.
+func orderedLess[T comparable]() func(a, b T) bool {
+	var zero T
+	var typ = reflect.TypeOf(zero)
+	if typ == nil { // T is an interface type
+		return nil
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return func(a, b T) bool {
+			return reflect.ValueOf(a).String() < reflect.ValueOf(b).String()
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) bool {
+			return reflect.ValueOf(a).Int() < reflect.ValueOf(b).Int()
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) bool {
+			return reflect.ValueOf(a).Uint() < reflect.ValueOf(b).Uint()
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) bool {
+			return reflect.ValueOf(a).Float() < reflect.ValueOf(b).Float()
+		}
+	default:
+		return nil
+	}
+}
+
+// MarshalJSON implements json.Marshaler. The items are encoded in insertion order.
+func (s *OrderedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.items())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the items in OrderedSet, keeping their order.
+func (s *OrderedSet[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	s.Clear().Add(items...)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler. The items are encoded in insertion order.
+func (s *OrderedSet[T]) MarshalYAML() (interface{}, error) {
+	return s.items(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the items in OrderedSet, keeping their order.
+func (s *OrderedSet[T]) UnmarshalYAML(value *yaml.Node) error {
+	var items []T
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	s.Clear().Add(items...)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of OrderedSet.
+func (s *OrderedSet[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of OrderedSet.
+func (s *OrderedSet[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// items returns the items in insertion order, which are never nil, so that an empty OrderedSet
+// is encoded as an empty list.
+func (s *OrderedSet[T]) items() []T {
+	var items = make([]T, 0, len(s.data))
+	for e := s.head; e != nil; e = e.next {
+		items = append(items, e.item)
+	}
+	return items
+}
+
+// MarshalJSON implements json.Marshaler. The items are encoded in ascending order.
+func (s *SortedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.items())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the items in SortedSet.
+// A zero SortedSet, e.g. a nil field allocated by json, orders the items by the < operator,
+// which requires T to be an ordered type.
+func (s *SortedSet[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	return s.reset(items)
+}
+
+// MarshalYAML implements yaml.Marshaler. The items are encoded in ascending order.
+func (s *SortedSet[T]) MarshalYAML() (interface{}, error) {
+	return s.items(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the items in SortedSet, see UnmarshalJSON.
+func (s *SortedSet[T]) UnmarshalYAML(value *yaml.Node) error {
+	var items []T
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	return s.reset(items)
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of SortedSet.
+func (s *SortedSet[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of SortedSet.
+func (s *SortedSet[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+func (s *SortedSet[T]) reset(items []T) error {
+	if s.cmp == nil {
+		less := orderedLess[T]()
+		if less == nil {
+			return fmt.Errorf("cannot decode into a zero SortedSet of unordered type %T, create it by NewSortedFunc", *new(T))
+		}
+		s.cmp = func(a, b T) int {
+			switch {
+			case less(a, b):
+				return -1
+			case less(b, a):
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+	s.Clear().Add(items...)
+	return nil
+}
+
+// items returns the items in ascending order, which are never nil, so that an empty SortedSet
+// is encoded as an empty list.
+func (s *SortedSet[T]) items() []T {
+	var items = make([]T, 0, s.Size())
+	s.Iterate(func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
@@ -0,0 +1,75 @@
+package sset
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func TestSet_JSON_SortsOrderedItems(t *testing.T) {
+	data, err := json.Marshal(New("c", "a", "b"))
+	assert.Nil(t, err)
+	assert.Equal(t, `["a","b","c"]`, string(data))
+
+	data, err = json.Marshal(New(3, -1, 2))
+	assert.Nil(t, err)
+	assert.Equal(t, `[-1,2,3]`, string(data))
+
+	type level uint16
+	data, err = json.Marshal(New[level](9, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, `[3,9]`, string(data))
+
+	data, err = json.Marshal(New(2.5, 0.5))
+	assert.Nil(t, err)
+	assert.Equal(t, `[0.5,2.5]`, string(data))
+
+	data, err = json.Marshal(&Set[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
+
+func TestSet_JSON_UnorderedItems(t *testing.T) {
+	type point struct{ X, Y int }
+	data, err := json.Marshal(New(point{1, 2}))
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"X":1,"Y":2}]`, string(data))
+}
+
+func TestSet_UnmarshalJSON(t *testing.T) {
+	var config struct {
+		Tags *Set[string] `json:"tags"`
+	}
+	assert.Nil(t, json.Unmarshal([]byte(`{"tags":["a","b","a"]}`), &config))
+	assert.True(t, config.Tags.Equal(New("a", "b")))
+
+	var s = New("x")
+	assert.Nil(t, json.Unmarshal([]byte(`["y"]`), s))
+	assert.True(t, s.Equal(New("y")))
+
+	assert.NotNil(t, json.Unmarshal([]byte(`[1]`), s))
+}
+
+func TestSet_YAML(t *testing.T) {
+	var config = struct {
+		Tags *Set[string] `yaml:"tags"`
+	}{Tags: New("b", "c", "a")}
+	data, err := yaml.Marshal(config)
+	assert.Nil(t, err)
+	assert.Equal(t, "tags:\n    - a\n    - b\n    - c\n", string(data))
+
+	config.Tags = nil
+	assert.Nil(t, yaml.Unmarshal([]byte("tags: [x, y]\n"), &config))
+	assert.True(t, config.Tags.Equal(New("x", "y")))
+}
+
+func TestSet_Text(t *testing.T) {
+	text, err := New(2, 1).MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, `[1,2]`, string(text))
+
+	var s Set[int]
+	assert.Nil(t, s.UnmarshalText(text))
+	assert.True(t, s.Equal(New(1, 2)))
+}
@@ -0,0 +1,51 @@
+package sset
+
+import "github.com/chaseSpace/bear/internal/combin"
+
+// The functions below return lazy iterators, because their outputs grow exponentially.
+// They stop when yield returns false, and read the items of the sets when each iteration starts.
+// The iterators can be ranged over with Go 1.23+, or passed to stream.From.
+
+// PowerSet returns an iterator over all the subsets of s, from the empty set up to s itself,
+// ordered by size. Each subset is a new Set.
+func PowerSet[T comparable](s *Set[T]) func(yield func(*Set[T]) bool) {
+	return func(yield func(*Set[T]) bool) {
+		var items = s.Slice()
+		for k := 0; k <= len(items); k++ {
+			var stopped bool
+			combin.Combinations(len(items), k, func(idx []int) bool {
+				var subset = New[T]()
+				for _, i := range idx {
+					subset.data[items[i]] = struct{}{}
+				}
+				stopped = !yield(subset)
+				return !stopped
+			})
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// CartesianProduct returns an iterator over the Cartesian product of sets. Each tuple is a new slice,
+// which has one item from each set, in the order of sets. It yields nothing if any set is empty,
+// and one empty tuple if no set is given.
+// Sets have no order, so the order of the tuples is unspecified, except that it is stable within an iteration.
+func CartesianProduct[T comparable](sets ...*Set[T]) func(yield func([]T) bool) {
+	return func(yield func([]T) bool) {
+		var items = make([][]T, len(sets))
+		var sizes = make([]int, len(sets))
+		for i, set := range sets {
+			items[i] = set.Slice()
+			sizes[i] = len(items[i])
+		}
+		combin.Product(sizes, func(idx []int) bool {
+			var tuple = make([]T, len(idx))
+			for i, j := range idx {
+				tuple[i] = items[i][j]
+			}
+			return yield(tuple)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package sset
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestPowerSet(t *testing.T) {
+	var subsets []*Set[string]
+	PowerSet(New("a", "b", "c"))(func(s *Set[string]) bool {
+		subsets = append(subsets, s)
+		return true
+	})
+	assert.Len(t, subsets, 8)
+	assert.True(t, subsets[0].IsEmpty())
+	assert.True(t, subsets[7].Equal(New("a", "b", "c")))
+	for i := 1; i < len(subsets); i++ {
+		assert.LessOrEqual(t, subsets[i-1].Size(), subsets[i].Size())
+	}
+	var seen = New[string]()
+	for _, s := range subsets {
+		items := s.Slice()
+		sort.Strings(items)
+		seen.Add(strings.Join(items, ","))
+	}
+	assert.Equal(t, 8, seen.Size())
+
+	var count int
+	PowerSet(New[int]())(func(s *Set[int]) bool {
+		count++
+		assert.True(t, s.IsEmpty())
+		return true
+	})
+	assert.Equal(t, 1, count)
+}
+
+func TestPowerSet_StopsEarly(t *testing.T) {
+	var items []int
+	for i := 0; i < 64; i++ {
+		items = append(items, i)
+	}
+	var count int
+	PowerSet(New(items...))(func(s *Set[int]) bool {
+		count++
+		return count < 100
+	})
+	assert.Equal(t, 100, count)
+}
+
+func TestCartesianProduct(t *testing.T) {
+	var tuples [][]string
+	CartesianProduct(New("x", "y"), New("1", "2", "3"))(func(tuple []string) bool {
+		tuples = append(tuples, tuple)
+		return true
+	})
+	assert.Len(t, tuples, 6)
+	var seen = New[string]()
+	for _, tuple := range tuples {
+		assert.True(t, tuple[0] == "x" || tuple[0] == "y")
+		assert.Contains(t, []string{"1", "2", "3"}, tuple[1])
+		seen.Add(tuple[0] + tuple[1])
+	}
+	assert.Equal(t, 6, seen.Size())
+
+	var count int
+	CartesianProduct(New(1), New[int]())(func([]int) bool {
+		count++
+		return true
+	})
+	assert.Equal(t, 0, count)
+
+	CartesianProduct[int]()(func(tuple []int) bool {
+		count++
+		assert.Empty(t, tuple)
+		return true
+	})
+	assert.Equal(t, 1, count)
+}
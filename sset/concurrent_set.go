@@ -0,0 +1,259 @@
+package sset
+
+import "sync"
+
+// ConcurrentSet is a Set that is safe for concurrent use by multiple goroutines.
+// Reads share a sync.RWMutex, while writes hold it exclusively.
+//
+// The callbacks of ForEach and Iterate run on a snapshot without holding the lock, so they can
+// call other methods of the set. The callbacks of Filter, Map and Compute run under the write lock,
+// so they must not call methods of the same set.
+type ConcurrentSet[T comparable] struct {
+	mu  sync.RWMutex
+	set *Set[T]
+}
+
+// NewConcurrent returns a new ConcurrentSet
+func NewConcurrent[T comparable](items ...T) *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: New(items...)}
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to ConcurrentSet
+func (s *ConcurrentSet[T]) Add(data ...T) *ConcurrentSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Add(data...)
+	return s
+}
+
+// Clone returns a copy of ConcurrentSet
+func (s *ConcurrentSet[T]) Clone() *ConcurrentSet[T] {
+	return &ConcurrentSet[T]{set: s.Snapshot()}
+}
+
+// Filter filters ConcurrentSet by f
+func (s *ConcurrentSet[T]) Filter(f func(T) bool) *ConcurrentSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Filter(f)
+	return s
+}
+
+// Clear clears ConcurrentSet
+func (s *ConcurrentSet[T]) Clear() *ConcurrentSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Clear()
+	return s
+}
+
+// Delete deletes data from ConcurrentSet
+func (s *ConcurrentSet[T]) Delete(data ...T) *ConcurrentSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Delete(data...)
+	return s
+}
+
+// ForEach iterates a snapshot of ConcurrentSet by f, without holding the lock
+func (s *ConcurrentSet[T]) ForEach(f func(T)) *ConcurrentSet[T] {
+	s.Snapshot().ForEach(f)
+	return s
+}
+
+// Map maps ConcurrentSet by f
+func (s *ConcurrentSet[T]) Map(f func(T) T) *ConcurrentSet[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Map(f)
+	return s
+}
+
+// Merge merges ConcurrentSet to self with other
+func (s *ConcurrentSet[T]) Merge(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	var snapshot = other.Snapshot()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.Merge(snapshot)
+	return s
+}
+
+// Intersect return a new ConcurrentSet, which is the intersection of ConcurrentSet and others
+func (s *ConcurrentSet[T]) Intersect(others ...*ConcurrentSet[T]) *ConcurrentSet[T] {
+	var snapshots = snapshotsOf(others)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &ConcurrentSet[T]{set: s.set.Intersect(snapshots...)}
+}
+
+// IntersectInPlace removes the items that are not in all of others from ConcurrentSet
+func (s *ConcurrentSet[T]) IntersectInPlace(others ...*ConcurrentSet[T]) *ConcurrentSet[T] {
+	var snapshots = snapshotsOf(others)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.IntersectInPlace(snapshots...)
+	return s
+}
+
+// Union returns a new ConcurrentSet which is the union of ConcurrentSet and others
+func (s *ConcurrentSet[T]) Union(others ...*ConcurrentSet[T]) *ConcurrentSet[T] {
+	var union = s.Snapshot()
+	for _, other := range others {
+		union.Merge(other.Snapshot())
+	}
+	return &ConcurrentSet[T]{set: union}
+}
+
+// Diff returns a new ConcurrentSet which is the difference set from ConcurrentSet to others.
+func (s *ConcurrentSet[T]) Diff(others ...*ConcurrentSet[T]) *ConcurrentSet[T] {
+	var snapshots = snapshotsOf(others)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &ConcurrentSet[T]{set: s.set.Diff(snapshots...)}
+}
+
+// DiffInPlace removes the items in any of others from ConcurrentSet
+func (s *ConcurrentSet[T]) DiffInPlace(others ...*ConcurrentSet[T]) *ConcurrentSet[T] {
+	var snapshots = snapshotsOf(others)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set.DiffInPlace(snapshots...)
+	return s
+}
+
+// SymmetricDiff returns a new ConcurrentSet which has the items that are in exactly one of ConcurrentSet and other
+func (s *ConcurrentSet[T]) SymmetricDiff(other *ConcurrentSet[T]) *ConcurrentSet[T] {
+	var snapshot = other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return &ConcurrentSet[T]{set: s.set.SymmetricDiff(snapshot)}
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// AddIfAbsent adds item to ConcurrentSet atomically if it is not present.
+// It returns true if item has been added.
+func (s *ConcurrentSet[T]) AddIfAbsent(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.set.Has(item) {
+		return false
+	}
+	s.set.Add(item)
+	return true
+}
+
+// Compute calls f with the underlying Set under the write lock, so that a compound operation runs atomically.
+// The Set must not be retained after f returns.
+func (s *ConcurrentSet[T]) Compute(f func(set *Set[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s.set)
+}
+
+// Snapshot returns a copy of ConcurrentSet as a plain Set
+func (s *ConcurrentSet[T]) Snapshot() *Set[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Clone()
+}
+
+// IsSubsetOf checks if ConcurrentSet is a subset of other
+func (s *ConcurrentSet[T]) IsSubsetOf(other *ConcurrentSet[T]) bool {
+	var snapshot = other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsSubsetOf(snapshot)
+}
+
+// IsProperSubsetOf checks if ConcurrentSet is a subset of other, and other has more items than ConcurrentSet
+func (s *ConcurrentSet[T]) IsProperSubsetOf(other *ConcurrentSet[T]) bool {
+	var snapshot = other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsProperSubsetOf(snapshot)
+}
+
+// IsSupersetOf checks if ConcurrentSet is a superset of other
+func (s *ConcurrentSet[T]) IsSupersetOf(other *ConcurrentSet[T]) bool {
+	var snapshot = other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsSupersetOf(snapshot)
+}
+
+// IsProperSupersetOf checks if ConcurrentSet is a superset of other, and ConcurrentSet has more items than other
+func (s *ConcurrentSet[T]) IsProperSupersetOf(other *ConcurrentSet[T]) bool {
+	var snapshot = other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsProperSupersetOf(snapshot)
+}
+
+// IsDisjoint checks if ConcurrentSet and other have no items in common
+func (s *ConcurrentSet[T]) IsDisjoint(other *ConcurrentSet[T]) bool {
+	var snapshot = other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsDisjoint(snapshot)
+}
+
+// Slice returns a slice of ConcurrentSet
+func (s *ConcurrentSet[T]) Slice() (copied []T) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Slice()
+}
+
+// Size returns the size of ConcurrentSet
+func (s *ConcurrentSet[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Size()
+}
+
+// Has checks if ConcurrentSet contains item
+func (s *ConcurrentSet[T]) Has(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Has(item)
+}
+
+// Equal checks if ConcurrentSet is equal to other
+func (s *ConcurrentSet[T]) Equal(other *ConcurrentSet[T]) bool {
+	var snapshot = other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Equal(snapshot)
+}
+
+// Join joins ConcurrentSet by sep
+func (s *ConcurrentSet[T]) Join(sep string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.Join(sep)
+}
+
+// IsEmpty checks if ConcurrentSet is empty
+func (s *ConcurrentSet[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.set.IsEmpty()
+}
+
+// Iterate calls f for each item in a snapshot of ConcurrentSet, without holding the lock.
+// If f returns false, Iterate stops the iteration.
+func (s *ConcurrentSet[T]) Iterate(f func(T) bool) {
+	s.Snapshot().Iterate(f)
+}
+
+// snapshotsOf takes the snapshots of sets one by one, so that it never holds two locks at once.
+func snapshotsOf[T comparable](sets []*ConcurrentSet[T]) []*Set[T] {
+	var snapshots = make([]*Set[T], 0, len(sets))
+	for _, set := range sets {
+		snapshots = append(snapshots, set.Snapshot())
+	}
+	return snapshots
+}
@@ -0,0 +1,96 @@
+package sset
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSet_ConcurrentAdd_AllItemsAdded(t *testing.T) {
+	s := NewConcurrent[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				s.Add(g*1000 + i)
+				s.Has(i)
+				s.Size()
+			}
+		}(g)
+	}
+	wg.Wait()
+	assert.Equal(t, 8000, s.Size())
+}
+
+func TestConcurrentSet_AddIfAbsent_OnlyOneWinner(t *testing.T) {
+	s := NewConcurrent[string]()
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var winners int
+	for g := 0; g < 16; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if s.AddIfAbsent("key") {
+				mu.Lock()
+				winners++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, winners)
+}
+
+func TestConcurrentSet_Compute_RunsAtomically(t *testing.T) {
+	s := NewConcurrent(0)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				s.Compute(func(set *Set[int]) {
+					// move the only item to the next number
+					n := set.Slice()[0]
+					set.Delete(n).Add(n + 1)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, []int{800}, s.Slice())
+}
+
+func TestConcurrentSet_ForEach_CallbackCanModifySet(t *testing.T) {
+	s := NewConcurrent(1, 2, 3)
+	s.ForEach(func(i int) {
+		s.Add(i * 10) // does not deadlock because the callback runs on a snapshot
+	})
+	assert.True(t, s.Equal(NewConcurrent(1, 2, 3, 10, 20, 30)))
+
+	var count int
+	s.Iterate(func(int) bool {
+		s.Delete(1)
+		count++
+		return true
+	})
+	assert.Equal(t, 6, count)
+	assert.False(t, s.Has(1))
+}
+
+func TestConcurrentSet_Algebra(t *testing.T) {
+	a := NewConcurrent(1, 2, 3)
+	b := NewConcurrent(2, 3, 4)
+	assert.True(t, a.Intersect(b).Equal(NewConcurrent(2, 3)))
+	assert.True(t, a.Union(b).Equal(NewConcurrent(1, 2, 3, 4)))
+	assert.True(t, a.Diff(b).Equal(NewConcurrent(1)))
+	assert.True(t, NewConcurrent(2).IsSubsetOf(a))
+	assert.True(t, a.Clone().Merge(b).Equal(NewConcurrent(1, 2, 3, 4)))
+	assert.Equal(t, 3, a.Size(), "Clone should not share data with the origin")
+	// operations on the set itself must not deadlock
+	assert.True(t, a.Equal(a))
+	assert.True(t, a.Merge(a).Equal(NewConcurrent(1, 2, 3)))
+}
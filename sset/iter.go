@@ -0,0 +1,101 @@
+//go:build go1.23
+
+package sset
+
+import (
+	"github.com/chaseSpace/bear/constraints"
+	"iter"
+)
+
+// FromSeq creates a new Set from the values of seq.
+func FromSeq[T comparable](seq iter.Seq[T]) *Set[T] {
+	var s = New[T]()
+	for item := range seq {
+		s.data[item] = struct{}{}
+	}
+	return s
+}
+
+// OrderedFromSeq creates a new OrderedSet from the values of seq, in the order they are yielded.
+func OrderedFromSeq[T comparable](seq iter.Seq[T]) *OrderedSet[T] {
+	var s = NewOrdered[T]()
+	for item := range seq {
+		s.Add(item)
+	}
+	return s
+}
+
+// SortedFromSeq creates a new SortedSet from the values of seq, which orders the items by the < operator.
+func SortedFromSeq[T constraints.Ordered](seq iter.Seq[T]) *SortedSet[T] {
+	var s = NewSorted[T]()
+	for item := range seq {
+		s.Add(item)
+	}
+	return s
+}
+
+// All returns an iterator over the items in Set. The iteration order is not specified.
+func (s *Set[T]) All() iter.Seq[T] {
+	return s.Iterate
+}
+
+// All returns an iterator over the items in a snapshot of ConcurrentSet, taken when the iteration starts.
+func (s *ConcurrentSet[T]) All() iter.Seq[T] {
+	return s.Iterate
+}
+
+// All returns an iterator over the items in a snapshot of ShardedSet, taken when the iteration starts.
+func (s *ShardedSet[T]) All() iter.Seq[T] {
+	return s.Iterate
+}
+
+// All returns an iterator over the items in OrderedSet in insertion order.
+func (s *OrderedSet[T]) All() iter.Seq[T] {
+	return s.Iterate
+}
+
+// Backward returns an iterator over the items in OrderedSet in reverse insertion order.
+func (s *OrderedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := s.tail; e != nil; {
+			prev := e.prev
+			if !yield(e.item) {
+				return
+			}
+			e = prev
+		}
+	}
+}
+
+// All returns an iterator over the items in SortedSet in ascending order. The loop body must not modify SortedSet.
+func (s *SortedSet[T]) All() iter.Seq[T] {
+	return s.Iterate
+}
+
+// Backward returns an iterator over the items in SortedSet in descending order.
+// The loop body must not modify SortedSet.
+func (s *SortedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []*sortedNode[T]
+		for n := s.root; n != nil || len(stack) > 0; n = n.left {
+			for ; n != nil; n = n.right {
+				stack = append(stack, n)
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.item) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the items in BitSet in ascending order.
+func (s *BitSet) All() iter.Seq[uint] {
+	return s.Iterate
+}
+
+// All returns an iterator over the items in Bitmap in ascending order.
+func (b *Bitmap) All() iter.Seq[uint32] {
+	return b.Iterate
+}
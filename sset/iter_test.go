@@ -0,0 +1,85 @@
+//go:build go1.23
+
+package sset
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAll_RangeOverFunc_YieldsAllItems(t *testing.T) {
+	s := New(1, 2, 3)
+	var sum int
+	for v := range s.All() {
+		sum += v
+	}
+	assert.Equal(t, 6, sum)
+}
+
+func TestFromSeq_RemovesDuplicates(t *testing.T) {
+	seq := func(yield func(int) bool) {
+		for _, v := range []int{1, 1, 2} {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	assert.True(t, FromSeq(seq).Equal(New(1, 2)))
+}
+
+func TestOrderedSet_AllAndBackward(t *testing.T) {
+	s := NewOrdered(1, 2, 3)
+	var forward, backward []int
+	for v := range s.All() {
+		forward = append(forward, v)
+	}
+	for v := range s.Backward() {
+		backward = append(backward, v)
+		s.Delete(v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, forward)
+	assert.Equal(t, []int{3, 2, 1}, backward)
+	assert.True(t, s.IsEmpty())
+}
+
+func TestOrderedFromSeq_KeepsOrder(t *testing.T) {
+	seq := func(yield func(int) bool) {
+		for _, v := range []int{3, 1, 3} {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	assert.Equal(t, []int{3, 1}, OrderedFromSeq(seq).Slice())
+}
+
+func TestSortedSet_AllAndBackward(t *testing.T) {
+	s := NewSorted(2, 3, 1)
+	var forward, backward []int
+	for v := range s.All() {
+		forward = append(forward, v)
+	}
+	for v := range s.Backward() {
+		backward = append(backward, v)
+		if v == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2, 3}, forward)
+	assert.Equal(t, []int{3, 2}, backward)
+	assert.Equal(t, []int{1, 2}, SortedFromSeq(s.All()).Range(0, 2).Slice())
+}
+
+func TestBitSetAndBitmap_All(t *testing.T) {
+	var got []uint
+	for v := range NewBitSet(70, 3).All() {
+		got = append(got, v)
+	}
+	assert.Equal(t, []uint{3, 70}, got)
+
+	var got32 []uint32
+	for v := range NewBitmap(1<<20, 3).All() {
+		got32 = append(got32, v)
+	}
+	assert.Equal(t, []uint32{3, 1 << 20}, got32)
+}
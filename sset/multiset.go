@@ -0,0 +1,240 @@
+package sset
+
+import "sort"
+
+// MultiSet is a set that counts how many times each item has been added, also known as a bag.
+// Add, Remove, Count and Has run in O(1).
+type MultiSet[T comparable] struct {
+	data map[T]int
+	size int
+}
+
+// ItemCount is an item of MultiSet with its count.
+type ItemCount[T comparable] struct {
+	Item  T
+	Count int
+}
+
+// NewMulti returns a new MultiSet, which counts each occurrence of items.
+func NewMulti[T comparable](items ...T) *MultiSet[T] {
+	var m = &MultiSet[T]{data: make(map[T]int)}
+	for _, item := range items {
+		m.Add(item, 1)
+	}
+	return m
+}
+
+// NewMultiFromSet returns a new MultiSet, which has each item of s once.
+func NewMultiFromSet[T comparable](s *Set[T]) *MultiSet[T] {
+	var m = &MultiSet[T]{data: make(map[T]int, s.Size())}
+	for k := range s.data {
+		m.data[k] = 1
+	}
+	m.size = len(m.data)
+	return m
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds item n times to MultiSet. It does nothing if n <= 0.
+func (m *MultiSet[T]) Add(item T, n int) *MultiSet[T] {
+	if n > 0 {
+		m.data[item] += n
+		m.size += n
+	}
+	return m
+}
+
+// Remove removes item n times from MultiSet. If item has been added at most n times, it is removed completely.
+// It does nothing if n <= 0.
+func (m *MultiSet[T]) Remove(item T, n int) *MultiSet[T] {
+	count, ok := m.data[item]
+	if !ok || n <= 0 {
+		return m
+	}
+	if n >= count {
+		delete(m.data, item)
+		m.size -= count
+		return m
+	}
+	m.data[item] = count - n
+	m.size -= n
+	return m
+}
+
+// RemoveAll removes all the occurrences of items from MultiSet.
+func (m *MultiSet[T]) RemoveAll(items ...T) *MultiSet[T] {
+	for _, item := range items {
+		m.size -= m.data[item]
+		delete(m.data, item)
+	}
+	return m
+}
+
+// SetCount sets the count of item. If n <= 0, item is removed.
+func (m *MultiSet[T]) SetCount(item T, n int) *MultiSet[T] {
+	m.RemoveAll(item)
+	return m.Add(item, n)
+}
+
+// Clone returns a copy of MultiSet
+func (m *MultiSet[T]) Clone() *MultiSet[T] {
+	var data = make(map[T]int, len(m.data))
+	for k, v := range m.data {
+		data[k] = v
+	}
+	return &MultiSet[T]{data: data, size: m.size}
+}
+
+// Clear clears MultiSet
+func (m *MultiSet[T]) Clear() *MultiSet[T] {
+	m.data = make(map[T]int)
+	m.size = 0
+	return m
+}
+
+// ForEach iterates the distinct items of MultiSet with their counts by f
+func (m *MultiSet[T]) ForEach(f func(item T, count int)) *MultiSet[T] {
+	for k, v := range m.data {
+		f(k, v)
+	}
+	return m
+}
+
+// Union returns a new MultiSet, in which the count of each item is the maximum of its counts in MultiSet and other.
+func (m *MultiSet[T]) Union(other *MultiSet[T]) *MultiSet[T] {
+	var result = m.Clone()
+	for k, v := range other.data {
+		if v > result.data[k] {
+			result.Add(k, v-result.data[k])
+		}
+	}
+	return result
+}
+
+// Intersect returns a new MultiSet, in which the count of each item is the minimum of its counts
+// in MultiSet and other.
+func (m *MultiSet[T]) Intersect(other *MultiSet[T]) *MultiSet[T] {
+	var small, large = m, other
+	if len(small.data) > len(large.data) {
+		small, large = large, small
+	}
+	var result = NewMulti[T]()
+	for k, v := range small.data {
+		if w := large.data[k]; w < v {
+			result.Add(k, w)
+		} else {
+			result.Add(k, v)
+		}
+	}
+	return result
+}
+
+// Sum returns a new MultiSet, in which the count of each item is the sum of its counts in MultiSet and other.
+func (m *MultiSet[T]) Sum(other *MultiSet[T]) *MultiSet[T] {
+	var result = m.Clone()
+	for k, v := range other.data {
+		result.Add(k, v)
+	}
+	return result
+}
+
+// Diff returns a new MultiSet, in which the count of each item is its count in MultiSet minus its count in other.
+// The items whose count drops to zero or below are removed.
+func (m *MultiSet[T]) Diff(other *MultiSet[T]) *MultiSet[T] {
+	var result = m.Clone()
+	for k, v := range other.data {
+		result.Remove(k, v)
+	}
+	return result
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Count returns how many times item is in MultiSet.
+func (m *MultiSet[T]) Count(item T) int {
+	return m.data[item]
+}
+
+// Has checks if MultiSet contains item
+func (m *MultiSet[T]) Has(item T) bool {
+	_, ok := m.data[item]
+	return ok
+}
+
+// Size returns the number of items in MultiSet, counting the repeated ones.
+func (m *MultiSet[T]) Size() int {
+	return m.size
+}
+
+// Distinct returns a Set of the distinct items in MultiSet.
+func (m *MultiSet[T]) Distinct() *Set[T] {
+	var s = &Set[T]{data: make(map[T]struct{}, len(m.data))}
+	for k := range m.data {
+		s.data[k] = struct{}{}
+	}
+	return s
+}
+
+// MostCommon returns the k items with the highest counts, in descending order of count.
+// Items with the same count are in ascending order if T is ordered, otherwise their order is unspecified.
+// If k <= 0 or k is greater than the number of distinct items, it returns all of them.
+func (m *MultiSet[T]) MostCommon(k int) []ItemCount[T] {
+	var counts = make([]ItemCount[T], 0, len(m.data))
+	for item, count := range m.data {
+		counts = append(counts, ItemCount[T]{Item: item, Count: count})
+	}
+	var less = orderedLess[T]()
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return less != nil && less(counts[i].Item, counts[j].Item)
+	})
+	if k > 0 && k < len(counts) {
+		counts = counts[:k]
+	}
+	return counts
+}
+
+// IsSubsetOf checks if the count of each item in MultiSet is less than or equal to its count in other
+func (m *MultiSet[T]) IsSubsetOf(other *MultiSet[T]) bool {
+	if m.size > other.size {
+		return false
+	}
+	for k, v := range m.data {
+		if other.data[k] < v {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal checks if MultiSet has the same items with the same counts as other
+func (m *MultiSet[T]) Equal(other *MultiSet[T]) bool {
+	return m.size == other.size && len(m.data) == len(other.data) && m.IsSubsetOf(other)
+}
+
+// Slice returns the items in MultiSet, each repeated by its count. The order of the distinct items is unspecified.
+func (m *MultiSet[T]) Slice() (copied []T) {
+	for k, v := range m.data {
+		for i := 0; i < v; i++ {
+			copied = append(copied, k)
+		}
+	}
+	return copied
+}
+
+// IsEmpty checks if MultiSet is empty
+func (m *MultiSet[T]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Iterate calls f for each distinct item in MultiSet with its count. If f returns false, Iterate stops the iteration.
+func (m *MultiSet[T]) Iterate(f func(item T, count int) bool) {
+	for k, v := range m.data {
+		if !f(k, v) {
+			return
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package sset
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+func TestMultiSet_AddRemoveCount(t *testing.T) {
+	m := NewMulti("a", "b", "a")
+	assert.Equal(t, 2, m.Count("a"))
+	assert.Equal(t, 1, m.Count("b"))
+	assert.Equal(t, 0, m.Count("c"))
+	assert.Equal(t, 3, m.Size())
+
+	m.Add("c", 3).Add("c", 0).Add("c", -1)
+	assert.Equal(t, 3, m.Count("c"))
+	assert.Equal(t, 6, m.Size())
+
+	m.Remove("c", 2)
+	assert.Equal(t, 1, m.Count("c"))
+	m.Remove("c", 5).Remove("x", 1).Remove("a", -1)
+	assert.False(t, m.Has("c"))
+	assert.Equal(t, 3, m.Size())
+
+	m.SetCount("b", 4)
+	assert.Equal(t, 4, m.Count("b"))
+	m.SetCount("b", 0)
+	assert.False(t, m.Has("b"))
+	assert.Equal(t, 2, m.Size())
+
+	m.RemoveAll("a", "z")
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, 0, m.Size())
+}
+
+func TestMultiSet_DistinctAndSlice(t *testing.T) {
+	m := NewMulti(1, 1, 2)
+	assert.True(t, m.Distinct().Equal(New(1, 2)))
+	items := m.Slice()
+	sort.Ints(items)
+	assert.Equal(t, []int{1, 1, 2}, items)
+
+	fromSet := NewMultiFromSet(New(1, 2))
+	assert.Equal(t, 2, fromSet.Size())
+	assert.Equal(t, 1, fromSet.Count(2))
+}
+
+func TestMultiSet_MostCommon(t *testing.T) {
+	m := NewMulti("b", "a", "c", "a", "b", "d", "a")
+	assert.Equal(t, []ItemCount[string]{{"a", 3}, {"b", 2}}, m.MostCommon(2))
+	assert.Equal(t, []ItemCount[string]{{"a", 3}, {"b", 2}, {"c", 1}, {"d", 1}}, m.MostCommon(0))
+	assert.Len(t, m.MostCommon(10), 4)
+	assert.Empty(t, NewMulti[string]().MostCommon(1))
+}
+
+func TestMultiSet_Algebra(t *testing.T) {
+	a := NewMulti("x", "x", "x", "y")
+	b := NewMulti("x", "y", "y", "z")
+
+	union := a.Union(b)
+	assert.True(t, union.Equal(NewMulti("x", "x", "x", "y", "y", "z")))
+	assert.Equal(t, 6, union.Size())
+
+	inter := a.Intersect(b)
+	assert.True(t, inter.Equal(NewMulti("x", "y")))
+	assert.Equal(t, 2, inter.Size())
+
+	sum := a.Sum(b)
+	assert.Equal(t, 4, sum.Count("x"))
+	assert.Equal(t, 8, sum.Size())
+
+	diff := a.Diff(b)
+	assert.True(t, diff.Equal(NewMulti("x", "x")))
+	assert.False(t, diff.Has("y"))
+
+	assert.Equal(t, 4, a.Size())
+	assert.True(t, inter.IsSubsetOf(a))
+	assert.False(t, a.IsSubsetOf(inter))
+	assert.False(t, NewMulti("x", "x").IsSubsetOf(NewMulti("x", "y", "z")))
+	assert.False(t, a.Equal(b))
+}
+
+func TestMultiSet_CloneClearIterate(t *testing.T) {
+	m := NewMulti(1, 2, 2)
+	cp := m.Clone()
+	m.Clear()
+	assert.True(t, m.IsEmpty())
+	assert.Equal(t, 3, cp.Size())
+
+	var total int
+	cp.ForEach(func(_ int, count int) { total += count })
+	assert.Equal(t, 3, total)
+
+	var visited int
+	cp.Iterate(func(int, int) bool {
+		visited++
+		return false
+	})
+	assert.Equal(t, 1, visited)
+}
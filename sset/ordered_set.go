@@ -0,0 +1,377 @@
+package sset
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OrderedSet is a Set that remembers the insertion order of its items, like Java's LinkedHashSet.
+// Slice, Join, ForEach and Iterate return the items in insertion order, so the output is deterministic.
+// Add, Delete and Has run in O(1). Adding an item that is already present does not change its position.
+type OrderedSet[T comparable] struct {
+	data       map[T]*orderedEntry[T]
+	head, tail *orderedEntry[T]
+}
+
+type orderedEntry[T comparable] struct {
+	item       T
+	prev, next *orderedEntry[T]
+}
+
+// NewOrdered returns a new OrderedSet with items in the given order. Duplicates keep their first position.
+func NewOrdered[T comparable](items ...T) *OrderedSet[T] {
+	var s = &OrderedSet[T]{data: make(map[T]*orderedEntry[T])}
+	return s.Add(items...)
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add appends data to the end of OrderedSet, skipping the items that are already present.
+func (s *OrderedSet[T]) Add(data ...T) *OrderedSet[T] {
+	for _, item := range data {
+		if _, ok := s.data[item]; !ok {
+			s.pushBack(item)
+		}
+	}
+	return s
+}
+
+// Clone returns a copy of OrderedSet
+func (s *OrderedSet[T]) Clone() *OrderedSet[T] {
+	return NewOrdered(s.Slice()...)
+}
+
+// Filter filters OrderedSet by f, it keeps the items that match f.
+func (s *OrderedSet[T]) Filter(f func(T) bool) *OrderedSet[T] {
+	for e := s.head; e != nil; {
+		next := e.next
+		if !f(e.item) {
+			s.unlink(e)
+		}
+		e = next
+	}
+	return s
+}
+
+// Clear clears OrderedSet
+func (s *OrderedSet[T]) Clear() *OrderedSet[T] {
+	s.data = make(map[T]*orderedEntry[T])
+	s.head, s.tail = nil, nil
+	return s
+}
+
+// Delete deletes data from OrderedSet
+func (s *OrderedSet[T]) Delete(data ...T) *OrderedSet[T] {
+	for _, item := range data {
+		if e, ok := s.data[item]; ok {
+			s.unlink(e)
+		}
+	}
+	return s
+}
+
+// ForEach iterates OrderedSet by f in insertion order. f may delete the current item.
+func (s *OrderedSet[T]) ForEach(f func(T)) *OrderedSet[T] {
+	for e := s.head; e != nil; {
+		next := e.next
+		f(e.item)
+		e = next
+	}
+	return s
+}
+
+// Map maps OrderedSet by f. The mapped items keep the order of the items they come from,
+// and if several items map to the same value, it takes the position of the first one.
+func (s *OrderedSet[T]) Map(f func(T) T) *OrderedSet[T] {
+	var items = s.Slice()
+	s.Clear()
+	for _, item := range items {
+		s.Add(f(item))
+	}
+	return s
+}
+
+// Merge merges OrderedSet to self with other, the new items are appended in the order of other.
+func (s *OrderedSet[T]) Merge(other *OrderedSet[T]) *OrderedSet[T] {
+	for e := other.head; e != nil; e = e.next {
+		s.Add(e.item)
+	}
+	return s
+}
+
+// Intersect returns a new OrderedSet, which is the intersection of OrderedSet and others, in the order of OrderedSet.
+func (s *OrderedSet[T]) Intersect(others ...*OrderedSet[T]) *OrderedSet[T] {
+	var newSet = NewOrdered[T]()
+	for e := s.head; e != nil; e = e.next {
+		if orderedHasInAll(others, e.item) {
+			newSet.pushBack(e.item)
+		}
+	}
+	return newSet
+}
+
+// IntersectInPlace removes the items that are not in all of others from OrderedSet, keeping the order of the rest.
+func (s *OrderedSet[T]) IntersectInPlace(others ...*OrderedSet[T]) *OrderedSet[T] {
+	return s.Filter(func(item T) bool {
+		return orderedHasInAll(others, item)
+	})
+}
+
+// Union returns a new OrderedSet which is the union of OrderedSet and others.
+// It has the items of OrderedSet first, followed by the new items of each other in turn.
+func (s *OrderedSet[T]) Union(others ...*OrderedSet[T]) *OrderedSet[T] {
+	var newSet = s.Clone()
+	for _, other := range others {
+		newSet.Merge(other)
+	}
+	return newSet
+}
+
+// Diff returns a new OrderedSet which is the difference set from OrderedSet to others, in the order of OrderedSet.
+func (s *OrderedSet[T]) Diff(others ...*OrderedSet[T]) *OrderedSet[T] {
+	var newSet = NewOrdered[T]()
+	for e := s.head; e != nil; e = e.next {
+		if !orderedHasInAny(others, e.item) {
+			newSet.pushBack(e.item)
+		}
+	}
+	return newSet
+}
+
+// DiffInPlace removes the items in any of others from OrderedSet, keeping the order of the rest.
+func (s *OrderedSet[T]) DiffInPlace(others ...*OrderedSet[T]) *OrderedSet[T] {
+	for _, other := range others {
+		if other.Size() < s.Size() {
+			for e := other.head; e != nil; e = e.next {
+				s.Delete(e.item)
+			}
+			continue
+		}
+		s.Filter(func(item T) bool {
+			return !other.Has(item)
+		})
+	}
+	return s
+}
+
+// SymmetricDiff returns a new OrderedSet which has the items that are in exactly one of OrderedSet and other.
+// The items of OrderedSet come first, followed by those of other, in their own order.
+func (s *OrderedSet[T]) SymmetricDiff(other *OrderedSet[T]) *OrderedSet[T] {
+	var newSet = s.Diff(other)
+	for e := other.head; e != nil; e = e.next {
+		if !s.Has(e.item) {
+			newSet.pushBack(e.item)
+		}
+	}
+	return newSet
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// First returns the first item in OrderedSet. The bool is false if OrderedSet is empty.
+func (s *OrderedSet[T]) First() (item T, ok bool) {
+	if s.head == nil {
+		return
+	}
+	return s.head.item, true
+}
+
+// Last returns the last item in OrderedSet. The bool is false if OrderedSet is empty.
+func (s *OrderedSet[T]) Last() (item T, ok bool) {
+	if s.tail == nil {
+		return
+	}
+	return s.tail.item, true
+}
+
+// PopFirst removes the first item in OrderedSet and returns it. The bool is false if OrderedSet is empty.
+func (s *OrderedSet[T]) PopFirst() (item T, ok bool) {
+	if s.head == nil {
+		return
+	}
+	item = s.head.item
+	s.unlink(s.head)
+	return item, true
+}
+
+// PopLast removes the last item in OrderedSet and returns it. The bool is false if OrderedSet is empty.
+func (s *OrderedSet[T]) PopLast() (item T, ok bool) {
+	if s.tail == nil {
+		return
+	}
+	item = s.tail.item
+	s.unlink(s.tail)
+	return item, true
+}
+
+// MoveToEnd moves item to the end of OrderedSet. It returns false if item is not present.
+func (s *OrderedSet[T]) MoveToEnd(item T) bool {
+	if _, ok := s.data[item]; !ok {
+		return false
+	}
+	s.unlink(s.data[item])
+	s.pushBack(item)
+	return true
+}
+
+// MoveToFront moves item to the front of OrderedSet. It returns false if item is not present.
+func (s *OrderedSet[T]) MoveToFront(item T) bool {
+	e, ok := s.data[item]
+	if !ok {
+		return false
+	}
+	if e == s.head {
+		return true
+	}
+	s.unlink(e)
+	e.prev, e.next = nil, s.head
+	if s.head != nil {
+		s.head.prev = e
+	} else {
+		s.tail = e
+	}
+	s.head = e
+	s.data[item] = e
+	return true
+}
+
+// IsSubsetOf checks if OrderedSet is a subset of other
+func (s *OrderedSet[T]) IsSubsetOf(other *OrderedSet[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for k := range s.data {
+		if !other.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProperSubsetOf checks if OrderedSet is a subset of other, and other has more items than OrderedSet
+func (s *OrderedSet[T]) IsProperSubsetOf(other *OrderedSet[T]) bool {
+	return s.Size() < other.Size() && s.IsSubsetOf(other)
+}
+
+// IsSupersetOf checks if OrderedSet is a superset of other
+func (s *OrderedSet[T]) IsSupersetOf(other *OrderedSet[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf checks if OrderedSet is a superset of other, and OrderedSet has more items than other
+func (s *OrderedSet[T]) IsProperSupersetOf(other *OrderedSet[T]) bool {
+	return other.IsProperSubsetOf(s)
+}
+
+// IsDisjoint checks if OrderedSet and other have no items in common. It iterates over the smaller one.
+func (s *OrderedSet[T]) IsDisjoint(other *OrderedSet[T]) bool {
+	var small, large = s, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	for k := range small.data {
+		if large.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Slice returns a slice of OrderedSet in insertion order
+func (s *OrderedSet[T]) Slice() (copied []T) {
+	for e := s.head; e != nil; e = e.next {
+		copied = append(copied, e.item)
+	}
+	return copied
+}
+
+// Size returns the size of OrderedSet
+func (s *OrderedSet[T]) Size() int {
+	return len(s.data)
+}
+
+// Has checks if OrderedSet contains item
+func (s *OrderedSet[T]) Has(item T) bool {
+	_, ok := s.data[item]
+	return ok
+}
+
+// Equal checks if OrderedSet has the same items as other, regardless of their order
+func (s *OrderedSet[T]) Equal(other *OrderedSet[T]) bool {
+	return s.Size() == other.Size() && s.IsSubsetOf(other)
+}
+
+// Join joins OrderedSet by sep in insertion order
+func (s *OrderedSet[T]) Join(sep string) string {
+	var ss []string
+	for e := s.head; e != nil; e = e.next {
+		ss = append(ss, fmt.Sprintf("%v", e.item))
+	}
+	return strings.Join(ss, sep)
+}
+
+// IsEmpty checks if OrderedSet is empty
+func (s *OrderedSet[T]) IsEmpty() bool {
+	return len(s.data) == 0
+}
+
+// Iterate calls f for each item in OrderedSet in insertion order. If f returns false, Iterate stops the iteration.
+// f may delete the current item.
+func (s *OrderedSet[T]) Iterate(f func(T) bool) {
+	for e := s.head; e != nil; {
+		next := e.next
+		if !f(e.item) {
+			return
+		}
+		e = next
+	}
+}
+
+// ---------------------- internal ----------------------
+
+func (s *OrderedSet[T]) pushBack(item T) {
+	if s.data == nil { // zero OrderedSet, e.g. created by an unmarshaler
+		s.data = make(map[T]*orderedEntry[T])
+	}
+	var e = &orderedEntry[T]{item: item, prev: s.tail}
+	if s.tail != nil {
+		s.tail.next = e
+	} else {
+		s.head = e
+	}
+	s.tail = e
+	s.data[item] = e
+}
+
+func (s *OrderedSet[T]) unlink(e *orderedEntry[T]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		s.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		s.tail = e.prev
+	}
+	e.prev, e.next = nil, nil
+	delete(s.data, e.item)
+}
+
+func orderedHasInAll[T comparable](sets []*OrderedSet[T], item T) bool {
+	for _, set := range sets {
+		if !set.Has(item) {
+			return false
+		}
+	}
+	return true
+}
+
+func orderedHasInAny[T comparable](sets []*OrderedSet[T], item T) bool {
+	for _, set := range sets {
+		if set.Has(item) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package sset
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOrderedSet_Add_KeepsInsertionOrder(t *testing.T) {
+	s := NewOrdered(3, 1, 3, 2)
+	s.Add(1, 5)
+	assert.Equal(t, []int{3, 1, 2, 5}, s.Slice())
+	assert.Equal(t, 4, s.Size())
+	assert.Equal(t, "3,1,2,5", s.Join(","))
+	assert.True(t, s.Has(5))
+	assert.False(t, s.Has(4))
+}
+
+func TestOrderedSet_Delete(t *testing.T) {
+	s := NewOrdered(1, 2, 3, 4)
+	s.Delete(1, 3, 9)
+	assert.Equal(t, []int{2, 4}, s.Slice())
+	s.Delete(4)
+	assert.Equal(t, []int{2}, s.Slice())
+	last, _ := s.Last()
+	assert.Equal(t, 2, last)
+	s.Delete(2)
+	assert.True(t, s.IsEmpty())
+	assert.Nil(t, s.Slice())
+	s.Add(7)
+	assert.Equal(t, []int{7}, s.Slice())
+}
+
+func TestOrderedSet_ReAddAfterDelete_MovesToEnd(t *testing.T) {
+	s := NewOrdered("a", "b", "c")
+	s.Delete("a").Add("a")
+	assert.Equal(t, []string{"b", "c", "a"}, s.Slice())
+}
+
+func TestOrderedSet_FirstLastPop(t *testing.T) {
+	s := NewOrdered[int]()
+	_, ok := s.First()
+	assert.False(t, ok)
+	_, ok = s.Last()
+	assert.False(t, ok)
+	_, ok = s.PopFirst()
+	assert.False(t, ok)
+	_, ok = s.PopLast()
+	assert.False(t, ok)
+
+	s.Add(1, 2, 3)
+	first, ok := s.First()
+	assert.True(t, ok)
+	assert.Equal(t, 1, first)
+	last, ok := s.Last()
+	assert.True(t, ok)
+	assert.Equal(t, 3, last)
+
+	first, _ = s.PopFirst()
+	assert.Equal(t, 1, first)
+	last, _ = s.PopLast()
+	assert.Equal(t, 3, last)
+	assert.Equal(t, []int{2}, s.Slice())
+	assert.False(t, s.Has(1))
+	assert.False(t, s.Has(3))
+}
+
+func TestOrderedSet_MoveToEndAndFront(t *testing.T) {
+	s := NewOrdered(1, 2, 3)
+	assert.True(t, s.MoveToEnd(1))
+	assert.Equal(t, []int{2, 3, 1}, s.Slice())
+	assert.True(t, s.MoveToEnd(1))
+	assert.Equal(t, []int{2, 3, 1}, s.Slice())
+	assert.True(t, s.MoveToFront(3))
+	assert.Equal(t, []int{3, 2, 1}, s.Slice())
+	assert.True(t, s.MoveToFront(3))
+	assert.Equal(t, []int{3, 2, 1}, s.Slice())
+	assert.True(t, s.MoveToFront(1))
+	assert.Equal(t, []int{1, 3, 2}, s.Slice())
+	assert.False(t, s.MoveToEnd(9))
+	assert.False(t, s.MoveToFront(9))
+
+	last, _ := s.Last()
+	assert.Equal(t, 2, last)
+	single := NewOrdered(1)
+	assert.True(t, single.MoveToFront(1))
+	assert.True(t, single.MoveToEnd(1))
+	assert.Equal(t, []int{1}, single.Slice())
+}
+
+func TestOrderedSet_FilterMapForEach(t *testing.T) {
+	s := NewOrdered(1, 2, 3, 4, 5)
+	s.Filter(func(i int) bool { return i%2 == 1 })
+	assert.Equal(t, []int{1, 3, 5}, s.Slice())
+
+	s.Map(func(i int) int { return i / 2 })
+	assert.Equal(t, []int{0, 1, 2}, s.Slice())
+
+	var visited []int
+	s.ForEach(func(i int) {
+		visited = append(visited, i)
+		s.Delete(i)
+	})
+	assert.Equal(t, []int{0, 1, 2}, visited)
+	assert.True(t, s.IsEmpty())
+}
+
+func TestOrderedSet_Iterate(t *testing.T) {
+	s := NewOrdered(1, 2, 3)
+	var visited []int
+	s.Iterate(func(i int) bool {
+		visited = append(visited, i)
+		return i < 2
+	})
+	assert.Equal(t, []int{1, 2}, visited)
+}
+
+func TestOrderedSet_Algebra(t *testing.T) {
+	a := NewOrdered(1, 2, 3, 4)
+	b := NewOrdered(5, 4, 2)
+	assert.Equal(t, []int{2, 4}, a.Intersect(b).Slice())
+	assert.Equal(t, []int{1, 3}, a.Diff(b).Slice())
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, a.Union(b, NewOrdered(6, 1)).Slice())
+	assert.Equal(t, []int{1, 2, 3, 4}, a.Slice())
+
+	a.Merge(b)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, a.Slice())
+	assert.True(t, b.IsSubsetOf(a))
+	assert.False(t, a.IsSubsetOf(b))
+}
+
+func TestOrderedSet_EqualIgnoresOrder(t *testing.T) {
+	assert.True(t, NewOrdered(1, 2).Equal(NewOrdered(2, 1)))
+	assert.False(t, NewOrdered(1, 2).Equal(NewOrdered(1)))
+	assert.False(t, NewOrdered(1, 2).Equal(NewOrdered(1, 3)))
+}
+
+func TestOrderedSet_CloneAndClear(t *testing.T) {
+	s := NewOrdered(1, 2)
+	cp := s.Clone()
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, []int{1, 2}, cp.Slice())
+	s.Add(3)
+	assert.Equal(t, []int{3}, s.Slice())
+}
+
+func TestOrderedSet_JSON(t *testing.T) {
+	data, err := json.Marshal(NewOrdered("b", "a", "c"))
+	assert.Nil(t, err)
+	assert.Equal(t, `["b","a","c"]`, string(data))
+
+	var s OrderedSet[string]
+	assert.Nil(t, json.Unmarshal([]byte(`["z","x","z"]`), &s))
+	assert.Equal(t, []string{"z", "x"}, s.Slice())
+
+	data, err = json.Marshal(&OrderedSet[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
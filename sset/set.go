@@ -88,17 +88,33 @@ func (s *Set[T]) Merge(other *Set[T]) *Set[T] {
 	return s
 }
 
-// Intersect return a new Set, which is the intersection of Set and other
-func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+// Intersect returns a new Set, which is the intersection of Set and others.
+// It iterates over the smallest operand, so intersecting a small set with a large one is fast.
+func (s *Set[T]) Intersect(others ...*Set[T]) *Set[T] {
+	var sets = append([]*Set[T]{s}, others...)
+	var smallest = smallestOf(sets)
 	var newSet = New[T]()
-	for k := range s.data {
-		if other.Has(k) {
-			newSet.Add(k)
+	for k := range sets[smallest].data {
+		if hasInAll(sets, smallest, k) {
+			newSet.data[k] = struct{}{}
 		}
 	}
 	return newSet
 }
 
+// IntersectInPlace removes the items that are not in all of others from Set, without allocating a new Set.
+func (s *Set[T]) IntersectInPlace(others ...*Set[T]) *Set[T] {
+	for k := range s.data {
+		for _, other := range others {
+			if !other.Has(k) {
+				delete(s.data, k)
+				break
+			}
+		}
+	}
+	return s
+}
+
 // Union returns a new Set which is the union of Set and others
 func (s *Set[T]) Union(others ...*Set[T]) *Set[T] {
 	var newSet = s.Clone()
@@ -112,6 +128,9 @@ func (s *Set[T]) Union(others ...*Set[T]) *Set[T] {
 
 // IsSubsetOf checks if Set is a subset of other
 func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
 	for k := range s.data {
 		if !other.Has(k) {
 			return false
@@ -120,12 +139,42 @@ func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
 	return true
 }
 
-// Diff returns a new Set which is the difference set from `set` to `others`.
-// Which means, all the items in `newSet` are in `set` but not in `others`.
-func (s *Set[T]) Diff(other *Set[T]) *Set[T] {
+// Diff returns a new Set which is the difference set from Set to others.
+// Which means, all the items in the new Set are in Set but not in any of others.
+func (s *Set[T]) Diff(others ...*Set[T]) *Set[T] {
 	var newSet = New[T]()
 	for k := range s.data {
-		if _, ok := other.data[k]; !ok {
+		if !hasInAny(others, k) {
+			newSet.data[k] = struct{}{}
+		}
+	}
+	return newSet
+}
+
+// DiffInPlace removes the items in any of others from Set, without allocating a new Set.
+// For each other, it iterates over the smaller one of Set and other.
+func (s *Set[T]) DiffInPlace(others ...*Set[T]) *Set[T] {
+	for _, other := range others {
+		if other.Size() < s.Size() {
+			for k := range other.data {
+				delete(s.data, k)
+			}
+			continue
+		}
+		for k := range s.data {
+			if other.Has(k) {
+				delete(s.data, k)
+			}
+		}
+	}
+	return s
+}
+
+// SymmetricDiff returns a new Set which has the items that are in exactly one of Set and other.
+func (s *Set[T]) SymmetricDiff(other *Set[T]) *Set[T] {
+	var newSet = s.Diff(other)
+	for k := range other.data {
+		if !s.Has(k) {
 			newSet.data[k] = struct{}{}
 		}
 	}
@@ -134,6 +183,35 @@ func (s *Set[T]) Diff(other *Set[T]) *Set[T] {
 
 // ---------------------- Non-Chained Methods ----------------------
 
+// IsProperSubsetOf checks if Set is a subset of other, and other has more items than Set
+func (s *Set[T]) IsProperSubsetOf(other *Set[T]) bool {
+	return s.Size() < other.Size() && s.IsSubsetOf(other)
+}
+
+// IsSupersetOf checks if Set is a superset of other
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf checks if Set is a superset of other, and Set has more items than other
+func (s *Set[T]) IsProperSupersetOf(other *Set[T]) bool {
+	return other.IsProperSubsetOf(s)
+}
+
+// IsDisjoint checks if Set and other have no items in common. It iterates over the smaller one.
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	var small, large = s, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	for k := range small.data {
+		if large.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
 // Slice returns a slice of Set
 func (s *Set[T]) Slice() (copied []T) {
 	for k := range s.data {
@@ -180,3 +258,43 @@ func (s *Set[T]) Join(sep string) string {
 func (s *Set[T]) IsEmpty() bool {
 	return len(s.data) == 0
 }
+
+// Iterate calls f for each item in Set. If f returns false, Iterate stops the iteration.
+func (s *Set[T]) Iterate(f func(T) bool) {
+	for k := range s.data {
+		if !f(k) {
+			return
+		}
+	}
+}
+
+// smallestOf returns the index of the smallest set.
+func smallestOf[T comparable](sets []*Set[T]) int {
+	var smallest int
+	for i, set := range sets {
+		if set.Size() < sets[smallest].Size() {
+			smallest = i
+		}
+	}
+	return smallest
+}
+
+// hasInAll checks if all the sets except sets[skip] contain item. skip may be -1 to check all the sets.
+func hasInAll[T comparable](sets []*Set[T], skip int, item T) bool {
+	for i, set := range sets {
+		if i != skip && !set.Has(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasInAny checks if any of the sets contains item.
+func hasInAny[T comparable](sets []*Set[T], item T) bool {
+	for _, set := range sets {
+		if set.Has(item) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package sset
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestIntersect_MultipleSets(t *testing.T) {
+	a, b, c := New(1, 2, 3, 4), New(2, 3, 4, 5), New(3, 4, 9)
+	assert.True(t, a.Intersect(b, c).Equal(New(3, 4)))
+	assert.True(t, c.Intersect(a, b).Equal(New(3, 4)))
+	assert.True(t, a.Intersect().Equal(a))
+	assert.True(t, a.Intersect(New[int]()).IsEmpty())
+	assert.Equal(t, 4, a.Size())
+}
+
+func TestIntersectInPlace(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	assert.Same(t, a, a.IntersectInPlace(New(2, 3, 4, 5), New(3, 4, 9)))
+	assert.True(t, a.Equal(New(3, 4)))
+	a.IntersectInPlace()
+	assert.True(t, a.Equal(New(3, 4)))
+}
+
+func TestDiff_MultipleSets(t *testing.T) {
+	a := New(1, 2, 3, 4, 5)
+	assert.True(t, a.Diff(New(1), New(2, 9)).Equal(New(3, 4, 5)))
+	assert.True(t, a.Diff().Equal(a))
+	assert.Equal(t, 5, a.Size())
+}
+
+func TestDiffInPlace(t *testing.T) {
+	a := New(1, 2, 3, 4, 5)
+	large := New(5, 6, 7, 8, 9, 10, 11)
+	assert.Same(t, a, a.DiffInPlace(New(1), large))
+	assert.True(t, a.Equal(New(2, 3, 4)))
+}
+
+func TestSymmetricDiff(t *testing.T) {
+	assert.True(t, New(1, 2, 3).SymmetricDiff(New(3, 4)).Equal(New(1, 2, 4)))
+	assert.True(t, New(1).SymmetricDiff(New(1)).IsEmpty())
+	assert.True(t, New[int]().SymmetricDiff(New(1)).Equal(New(1)))
+}
+
+func TestSubsetAndSupersetPredicates(t *testing.T) {
+	small, large := New(1, 2), New(1, 2, 3)
+	assert.True(t, small.IsSubsetOf(large))
+	assert.True(t, small.IsProperSubsetOf(large))
+	assert.False(t, large.IsProperSubsetOf(small))
+	assert.True(t, small.IsSubsetOf(New(2, 1)))
+	assert.False(t, small.IsProperSubsetOf(New(2, 1)))
+
+	assert.True(t, large.IsSupersetOf(small))
+	assert.True(t, large.IsProperSupersetOf(small))
+	assert.True(t, large.IsSupersetOf(large))
+	assert.False(t, large.IsProperSupersetOf(large))
+	assert.False(t, small.IsSupersetOf(large))
+
+	assert.True(t, New[int]().IsSubsetOf(small))
+	assert.True(t, New[int]().IsProperSubsetOf(small))
+}
+
+func TestIsDisjoint(t *testing.T) {
+	assert.True(t, New(1, 2).IsDisjoint(New(3, 4, 5)))
+	assert.False(t, New(1, 2).IsDisjoint(New(2, 4, 5)))
+	assert.False(t, New(2, 4, 5).IsDisjoint(New(1, 2)))
+	assert.True(t, New[int]().IsDisjoint(New[int]()))
+}
+
+func TestOrderedSet_CompleteAlgebra(t *testing.T) {
+	a := NewOrdered(4, 3, 2, 1)
+	assert.Equal(t, []int{4, 3}, a.Intersect(NewOrdered(3, 4, 5), NewOrdered(9, 4, 3)).Slice())
+	assert.Equal(t, []int{4, 1}, a.Diff(NewOrdered(2), NewOrdered(3)).Slice())
+	assert.Equal(t, []int{4, 1, 5}, a.SymmetricDiff(NewOrdered(5, 3, 2)).Slice())
+
+	b := a.Clone().IntersectInPlace(NewOrdered(1, 3))
+	assert.Equal(t, []int{3, 1}, b.Slice())
+	c := a.Clone().DiffInPlace(NewOrdered(3), NewOrdered(1, 2, 3, 5, 6, 7))
+	assert.Equal(t, []int{4}, c.Slice())
+
+	assert.True(t, b.IsProperSubsetOf(a))
+	assert.True(t, a.IsSupersetOf(b))
+	assert.True(t, a.IsProperSupersetOf(b))
+	assert.False(t, a.IsDisjoint(b))
+	assert.True(t, c.IsDisjoint(b))
+}
+
+func TestSortedSet_CompleteAlgebra(t *testing.T) {
+	a := NewSorted(4, 3, 2, 1)
+	assert.Equal(t, []int{3, 4}, a.Intersect(NewSorted(3, 4, 5), NewSorted(9, 4, 3)).Slice())
+	assert.Equal(t, []int{1, 4}, a.Diff(NewSorted(2), NewSorted(3)).Slice())
+	assert.Equal(t, []int{1, 4, 5}, a.SymmetricDiff(NewSorted(5, 3, 2)).Slice())
+
+	b := a.Clone().IntersectInPlace(NewSorted(1, 3))
+	assert.Equal(t, []int{1, 3}, b.Slice())
+	c := a.Clone().DiffInPlace(NewSorted(3), NewSorted(1, 2, 3, 5, 6, 7))
+	assert.Equal(t, []int{4}, c.Slice())
+	checkAVL(t, c, c.root)
+
+	assert.True(t, b.IsProperSubsetOf(a))
+	assert.True(t, a.IsSupersetOf(b))
+	assert.True(t, a.IsProperSupersetOf(b))
+	assert.False(t, a.IsDisjoint(b))
+	assert.True(t, c.IsDisjoint(b))
+}
+
+func TestConcurrentSet_CompleteAlgebra(t *testing.T) {
+	a := NewConcurrent(1, 2, 3, 4)
+	assert.True(t, a.Intersect(NewConcurrent(2, 3, 4), NewConcurrent(3, 4)).Snapshot().Equal(New(3, 4)))
+	assert.True(t, a.Diff(NewConcurrent(1), NewConcurrent(2)).Snapshot().Equal(New(3, 4)))
+	assert.True(t, a.SymmetricDiff(NewConcurrent(4, 5)).Snapshot().Equal(New(1, 2, 3, 5)))
+	assert.True(t, a.Clone().IntersectInPlace(NewConcurrent(1, 2)).Snapshot().Equal(New(1, 2)))
+	assert.True(t, a.Clone().DiffInPlace(NewConcurrent(1, 2)).Snapshot().Equal(New(3, 4)))
+	assert.True(t, a.IsSupersetOf(NewConcurrent(1)))
+	assert.True(t, a.IsProperSupersetOf(NewConcurrent(1)))
+	assert.True(t, NewConcurrent(1).IsProperSubsetOf(a))
+	assert.True(t, a.IsDisjoint(NewConcurrent(9)))
+	assert.True(t, a.Intersect(a).Equal(a))
+}
+
+func TestShardedSet_CompleteAlgebra(t *testing.T) {
+	a := NewSharded(4, 1, 2, 3, 4)
+	assert.True(t, a.Intersect(NewSharded(2, 2, 3, 4), NewSharded(8, 3, 4)).Snapshot().Equal(New(3, 4)))
+	assert.True(t, a.Diff(NewSharded(0, 1), NewSharded(0, 2)).Snapshot().Equal(New(3, 4)))
+	assert.True(t, a.SymmetricDiff(NewSharded(0, 4, 5)).Snapshot().Equal(New(1, 2, 3, 5)))
+	assert.True(t, a.Clone().IntersectInPlace(NewSharded(0, 1, 2)).Snapshot().Equal(New(1, 2)))
+	assert.True(t, a.Clone().DiffInPlace(NewSharded(0, 1, 2)).Snapshot().Equal(New(3, 4)))
+	assert.True(t, a.IsSupersetOf(NewSharded(0, 1)))
+	assert.True(t, a.IsProperSupersetOf(NewSharded(0, 1)))
+	assert.True(t, NewSharded(0, 1).IsProperSubsetOf(a))
+	assert.True(t, a.IsDisjoint(NewSharded(0, 9)))
+	assert.True(t, a.Intersect(a).Equal(a))
+
+	// the results place items in the shards by hash, so Has finds them
+	assert.True(t, a.Intersect(NewSharded(0, 3)).Has(3))
+	assert.True(t, a.SymmetricDiff(NewSharded(0, 5)).Has(5))
+}
+
+func BenchmarkIntersect_SmallWithLarge(b *testing.B) {
+	var small, large = New[int](), New[int]()
+	for i := 0; i < 10; i++ {
+		small.Add(i * 1000)
+	}
+	for i := 0; i < 1_000_000; i++ {
+		large.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		large.Intersect(small)
+	}
+}
@@ -561,3 +561,13 @@ func TestJoin_EmptySeparator_ReturnsConcatenatedString(t *testing.T) {
 	result := s.Join("")
 	assert.Equal(t, "helloworld", result)
 }
+
+func TestIterate_StopsWhenFalseReturned(t *testing.T) {
+	set := New(1, 2, 3)
+	var count int
+	set.Iterate(func(int) bool {
+		count++
+		return count < 2
+	})
+	assert.Equal(t, 2, count)
+}
@@ -0,0 +1,357 @@
+package sset
+
+import (
+	"fmt"
+	"github.com/chaseSpace/bear/internal/hashing"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// ShardedSet is a concurrency-safe Set that spreads its items across several shards by hash,
+// each guarded by its own sync.RWMutex, so that goroutines working on different shards do not contend.
+// It suits hot paths with many concurrent Has/Add calls better than ConcurrentSet.
+//
+// Operations on a single item lock one shard. Operations on the whole set (Size, Slice, Union, etc.)
+// lock all shards in a fixed order, so they see a consistent view.
+type ShardedSet[T comparable] struct {
+	shards []*shard[T]
+	mask   uint64
+	hasher func(T) uint64
+}
+
+type shard[T comparable] struct {
+	mu   sync.RWMutex
+	data map[T]struct{}
+	_    [32]byte // pad the shard to a cache line, so adjacent shards do not false share
+}
+
+// NewSharded returns a new ShardedSet with the given number of shards, which is rounded up to a power of two.
+// If shardCount <= 0, it uses 4 times GOMAXPROCS.
+// It uses a default hasher, which is fast for strings, numbers, bools and pointers (including named types of them),
+// and hashes pointers by address, the same way == compares them. Structs, arrays and interfaces are hashed
+// with reflect, which is slower, so NewShardedWithHasher can be used for them.
+func NewSharded[T comparable](shardCount int, items ...T) *ShardedSet[T] {
+	return NewShardedWithHasher(shardCount, hashing.Default[T](), items...)
+}
+
+// NewShardedWithHasher returns a new ShardedSet like NewSharded, which uses hasher to choose the shard of an item.
+// Equal items must have the same hash.
+func NewShardedWithHasher[T comparable](shardCount int, hasher func(T) uint64, items ...T) *ShardedSet[T] {
+	if shardCount <= 0 {
+		shardCount = 4 * runtime.GOMAXPROCS(0)
+	}
+	var n = 1
+	for n < shardCount {
+		n <<= 1
+	}
+	var s = &ShardedSet[T]{shards: make([]*shard[T], n), mask: uint64(n - 1), hasher: hasher}
+	for i := range s.shards {
+		s.shards[i] = &shard[T]{data: make(map[T]struct{})}
+	}
+	for _, item := range items {
+		s.shardOf(item).data[item] = struct{}{}
+	}
+	return s
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to ShardedSet
+func (s *ShardedSet[T]) Add(data ...T) *ShardedSet[T] {
+	for _, item := range data {
+		sh := s.shardOf(item)
+		sh.mu.Lock()
+		sh.data[item] = struct{}{}
+		sh.mu.Unlock()
+	}
+	return s
+}
+
+// Clone returns a copy of ShardedSet
+func (s *ShardedSet[T]) Clone() *ShardedSet[T] {
+	s.rlockAll()
+	defer s.runlockAll()
+	var cp = s.empty()
+	for i, sh := range s.shards {
+		for k := range sh.data {
+			cp.shards[i].data[k] = struct{}{}
+		}
+	}
+	return cp
+}
+
+// Filter filters ShardedSet by f, it keeps the items that match f.
+// f runs under the shard locks, so it must not call methods of the same set.
+func (s *ShardedSet[T]) Filter(f func(T) bool) *ShardedSet[T] {
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+		for k := range sh.data {
+			if !f(k) {
+				delete(sh.data, k)
+			}
+		}
+		sh.mu.Unlock()
+	}
+	return s
+}
+
+// Clear clears ShardedSet
+func (s *ShardedSet[T]) Clear() *ShardedSet[T] {
+	s.lockAll()
+	defer s.unlockAll()
+	for _, sh := range s.shards {
+		sh.data = make(map[T]struct{})
+	}
+	return s
+}
+
+// Delete deletes data from ShardedSet
+func (s *ShardedSet[T]) Delete(data ...T) *ShardedSet[T] {
+	for _, item := range data {
+		sh := s.shardOf(item)
+		sh.mu.Lock()
+		delete(sh.data, item)
+		sh.mu.Unlock()
+	}
+	return s
+}
+
+// ForEach iterates a snapshot of ShardedSet by f, without holding the locks
+func (s *ShardedSet[T]) ForEach(f func(T)) *ShardedSet[T] {
+	s.Snapshot().ForEach(f)
+	return s
+}
+
+// Map maps ShardedSet by f. f runs under the shard locks, so it must not call methods of the same set.
+func (s *ShardedSet[T]) Map(f func(T) T) *ShardedSet[T] {
+	s.lockAll()
+	defer s.unlockAll()
+	var mapped = make([]map[T]struct{}, len(s.shards))
+	for i := range mapped {
+		mapped[i] = make(map[T]struct{})
+	}
+	for _, sh := range s.shards {
+		for k := range sh.data {
+			v := f(k)
+			mapped[s.hasher(v)&s.mask][v] = struct{}{}
+		}
+	}
+	for i, sh := range s.shards {
+		sh.data = mapped[i]
+	}
+	return s
+}
+
+// Merge merges ShardedSet to self with other
+func (s *ShardedSet[T]) Merge(other *ShardedSet[T]) *ShardedSet[T] {
+	return s.Add(other.Slice()...)
+}
+
+// Intersect return a new ShardedSet, which is the intersection of ShardedSet and others
+func (s *ShardedSet[T]) Intersect(others ...*ShardedSet[T]) *ShardedSet[T] {
+	var snapshots = shardedSnapshotsOf(others)
+	return s.fromSet(s.Snapshot().IntersectInPlace(snapshots...))
+}
+
+// IntersectInPlace removes the items that are not in all of others from ShardedSet
+func (s *ShardedSet[T]) IntersectInPlace(others ...*ShardedSet[T]) *ShardedSet[T] {
+	var snapshots = shardedSnapshotsOf(others)
+	return s.Filter(func(item T) bool {
+		return hasInAll(snapshots, -1, item)
+	})
+}
+
+// Union returns a new ShardedSet which is the union of ShardedSet and others
+func (s *ShardedSet[T]) Union(others ...*ShardedSet[T]) *ShardedSet[T] {
+	var result = s.Clone()
+	for _, other := range others {
+		result.Merge(other)
+	}
+	return result
+}
+
+// Diff returns a new ShardedSet which is the difference set from ShardedSet to others.
+func (s *ShardedSet[T]) Diff(others ...*ShardedSet[T]) *ShardedSet[T] {
+	var snapshots = shardedSnapshotsOf(others)
+	return s.fromSet(s.Snapshot().DiffInPlace(snapshots...))
+}
+
+// DiffInPlace removes the items in any of others from ShardedSet
+func (s *ShardedSet[T]) DiffInPlace(others ...*ShardedSet[T]) *ShardedSet[T] {
+	var snapshots = shardedSnapshotsOf(others)
+	return s.Filter(func(item T) bool {
+		return !hasInAny(snapshots, item)
+	})
+}
+
+// SymmetricDiff returns a new ShardedSet which has the items that are in exactly one of ShardedSet and other
+func (s *ShardedSet[T]) SymmetricDiff(other *ShardedSet[T]) *ShardedSet[T] {
+	var snapshot = other.Snapshot()
+	return s.fromSet(s.Snapshot().SymmetricDiff(snapshot))
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// AddIfAbsent adds item to ShardedSet atomically if it is not present.
+// It returns true if item has been added.
+func (s *ShardedSet[T]) AddIfAbsent(item T) bool {
+	sh := s.shardOf(item)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	if _, ok := sh.data[item]; ok {
+		return false
+	}
+	sh.data[item] = struct{}{}
+	return true
+}
+
+// Snapshot returns a consistent copy of ShardedSet as a plain Set
+func (s *ShardedSet[T]) Snapshot() *Set[T] {
+	s.rlockAll()
+	defer s.runlockAll()
+	var set = New[T]()
+	for _, sh := range s.shards {
+		for k := range sh.data {
+			set.data[k] = struct{}{}
+		}
+	}
+	return set
+}
+
+// IsSubsetOf checks if ShardedSet is a subset of other
+func (s *ShardedSet[T]) IsSubsetOf(other *ShardedSet[T]) bool {
+	return s.Snapshot().IsSubsetOf(other.Snapshot())
+}
+
+// IsProperSubsetOf checks if ShardedSet is a subset of other, and other has more items than ShardedSet
+func (s *ShardedSet[T]) IsProperSubsetOf(other *ShardedSet[T]) bool {
+	return s.Snapshot().IsProperSubsetOf(other.Snapshot())
+}
+
+// IsSupersetOf checks if ShardedSet is a superset of other
+func (s *ShardedSet[T]) IsSupersetOf(other *ShardedSet[T]) bool {
+	return s.Snapshot().IsSupersetOf(other.Snapshot())
+}
+
+// IsProperSupersetOf checks if ShardedSet is a superset of other, and ShardedSet has more items than other
+func (s *ShardedSet[T]) IsProperSupersetOf(other *ShardedSet[T]) bool {
+	return s.Snapshot().IsProperSupersetOf(other.Snapshot())
+}
+
+// IsDisjoint checks if ShardedSet and other have no items in common
+func (s *ShardedSet[T]) IsDisjoint(other *ShardedSet[T]) bool {
+	return s.Snapshot().IsDisjoint(other.Snapshot())
+}
+
+// Slice returns a slice of ShardedSet
+func (s *ShardedSet[T]) Slice() (copied []T) {
+	s.rlockAll()
+	defer s.runlockAll()
+	for _, sh := range s.shards {
+		for k := range sh.data {
+			copied = append(copied, k)
+		}
+	}
+	return copied
+}
+
+// Size returns the size of ShardedSet
+func (s *ShardedSet[T]) Size() int {
+	s.rlockAll()
+	defer s.runlockAll()
+	var size int
+	for _, sh := range s.shards {
+		size += len(sh.data)
+	}
+	return size
+}
+
+// Has checks if ShardedSet contains item
+func (s *ShardedSet[T]) Has(item T) bool {
+	sh := s.shardOf(item)
+	sh.mu.RLock()
+	_, ok := sh.data[item]
+	sh.mu.RUnlock()
+	return ok
+}
+
+// Equal checks if ShardedSet is equal to other
+func (s *ShardedSet[T]) Equal(other *ShardedSet[T]) bool {
+	return s.Snapshot().Equal(other.Snapshot())
+}
+
+// Join joins ShardedSet by sep
+func (s *ShardedSet[T]) Join(sep string) string {
+	var ss []string
+	for _, item := range s.Slice() {
+		ss = append(ss, fmt.Sprintf("%v", item))
+	}
+	return strings.Join(ss, sep)
+}
+
+// IsEmpty checks if ShardedSet is empty
+func (s *ShardedSet[T]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Iterate calls f for each item in a snapshot of ShardedSet, without holding the locks.
+// If f returns false, Iterate stops the iteration.
+func (s *ShardedSet[T]) Iterate(f func(T) bool) {
+	s.Snapshot().Iterate(f)
+}
+
+// ---------------------- internal ----------------------
+
+func (s *ShardedSet[T]) shardOf(item T) *shard[T] {
+	return s.shards[s.hasher(item)&s.mask]
+}
+
+// empty returns a new empty ShardedSet with the same shard count and hasher.
+func (s *ShardedSet[T]) empty() *ShardedSet[T] {
+	return NewShardedWithHasher(len(s.shards), s.hasher)
+}
+
+// fromSet returns a new ShardedSet with the same shard count and hasher, which has the items of set.
+func (s *ShardedSet[T]) fromSet(set *Set[T]) *ShardedSet[T] {
+	var result = s.empty()
+	for k := range set.data {
+		result.shardOf(k).data[k] = struct{}{}
+	}
+	return result
+}
+
+// shardedSnapshotsOf takes the snapshots of sets one by one, so that it never locks two sets at once.
+func shardedSnapshotsOf[T comparable](sets []*ShardedSet[T]) []*Set[T] {
+	var snapshots = make([]*Set[T], 0, len(sets))
+	for _, set := range sets {
+		snapshots = append(snapshots, set.Snapshot())
+	}
+	return snapshots
+}
+
+// The *All methods always lock the shards in index order, so they can not deadlock with each other.
+
+func (s *ShardedSet[T]) lockAll() {
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+	}
+}
+
+func (s *ShardedSet[T]) unlockAll() {
+	for _, sh := range s.shards {
+		sh.mu.Unlock()
+	}
+}
+
+func (s *ShardedSet[T]) rlockAll() {
+	for _, sh := range s.shards {
+		sh.mu.RLock()
+	}
+}
+
+func (s *ShardedSet[T]) runlockAll() {
+	for _, sh := range s.shards {
+		sh.mu.RUnlock()
+	}
+}
@@ -0,0 +1,141 @@
+package sset
+
+import (
+	"github.com/chaseSpace/bear/internal/hashing"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestNewSharded_RoundsShardCountUpToPowerOfTwo(t *testing.T) {
+	assert.Len(t, NewSharded[int](5).shards, 8)
+	assert.Len(t, NewSharded[int](16).shards, 16)
+	assert.Len(t, NewSharded[int](1).shards, 1)
+	assert.NotEmpty(t, NewSharded[int](0).shards)
+}
+
+func TestShardedSet_BasicOperations(t *testing.T) {
+	s := NewSharded(4, "a", "b")
+	s.Add("c", "a")
+	assert.Equal(t, 3, s.Size())
+	assert.True(t, s.Has("c"))
+	assert.False(t, s.Has("d"))
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, s.Slice())
+
+	s.Delete("a")
+	assert.False(t, s.Has("a"))
+	assert.True(t, s.AddIfAbsent("a"))
+	assert.False(t, s.AddIfAbsent("a"))
+
+	s.Filter(func(x string) bool { return x != "b" })
+	assert.ElementsMatch(t, []string{"a", "c"}, s.Slice())
+
+	s.Map(func(x string) string { return x + x })
+	assert.True(t, s.Snapshot().Equal(New("aa", "cc")))
+	assert.Contains(t, []string{"aa,cc", "cc,aa"}, s.Join(","))
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func TestShardedSet_Algebra(t *testing.T) {
+	a := NewSharded(8, 1, 2, 3)
+	b := NewSharded(2, 2, 3, 4)
+	assert.True(t, a.Intersect(b).Equal(NewSharded(1, 2, 3)))
+	assert.True(t, a.Union(b).Equal(NewSharded(4, 1, 2, 3, 4)))
+	assert.True(t, a.Diff(b).Equal(NewSharded(4, 1)))
+	assert.True(t, NewSharded(4, 2).IsSubsetOf(a))
+	assert.False(t, a.IsSubsetOf(b))
+	assert.True(t, a.Clone().Merge(b).Equal(NewSharded(4, 1, 2, 3, 4)))
+	assert.Equal(t, 3, a.Size(), "Clone should not share data with the origin")
+	assert.True(t, a.Intersect(a).Equal(a))
+}
+
+func TestShardedSet_CustomHasher(t *testing.T) {
+	type key struct {
+		tenant string
+		id     int
+	}
+	hasher := func(k key) uint64 { return hashing.String(k.tenant) ^ hashing.Mix64(uint64(k.id)) }
+	s := NewShardedWithHasher(4, hasher, key{"a", 1}, key{"b", 2})
+	assert.True(t, s.Has(key{"a", 1}))
+	assert.False(t, s.Has(key{"a", 2}))
+
+	// the fallback hasher also works for structs
+	d := NewSharded(4, key{"a", 1})
+	assert.True(t, d.Has(key{"a", 1}))
+}
+
+func TestShardedSet_PointerKeys(t *testing.T) {
+	type user struct {
+		id int
+	}
+	u, other := &user{1}, &user{1}
+	s := NewSharded(64, u)
+	assert.False(t, s.Has(other)) // == compares the address, not the value pointed to
+
+	// changing the value pointed to does not change the key
+	u.id = 2
+	assert.True(t, s.Has(u))
+	s.Delete(u)
+	assert.False(t, s.Has(u))
+	assert.Equal(t, 0, s.Size())
+}
+
+func TestShardedSet_ConcurrentAddIfAbsent_OnlyOneWinnerPerKey(t *testing.T) {
+	s := NewSharded[int](8)
+	var added int64
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if s.AddIfAbsent(i) {
+					atomic.AddInt64(&added, 1)
+				}
+				s.Size()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(1000), added)
+	assert.Equal(t, 1000, s.Size())
+}
+
+// The benchmarks below compare ShardedSet with the single-mutex ConcurrentSet under a read-heavy
+// dedup workload (90% Has, 10% Add). Run them with different -cpu values to see how they scale:
+//
+//	go test ./sset -run=^$ -bench=Parallel -cpu=1,4,16
+
+func benchmarkParallel(b *testing.B, has func(string) bool, add func(string)) {
+	var keys = make([]string, 4096)
+	for i := range keys {
+		keys[i] = "req-" + strconv.Itoa(i)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		var i int
+		for pb.Next() {
+			k := keys[i&4095]
+			if i%10 == 0 {
+				add(k)
+			} else {
+				has(k)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkShardedSet_Parallel(b *testing.B) {
+	s := NewSharded[string](0)
+	benchmarkParallel(b, s.Has, func(k string) { s.Add(k) })
+}
+
+func BenchmarkConcurrentSet_Parallel(b *testing.B) {
+	s := NewConcurrent[string]()
+	benchmarkParallel(b, s.Has, func(k string) { s.Add(k) })
+}
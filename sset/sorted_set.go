@@ -0,0 +1,578 @@
+package sset
+
+import (
+	"fmt"
+	"github.com/chaseSpace/bear/constraints"
+	"strings"
+)
+
+// SortedSet is a Set that keeps its items in ascending order, backed by a size-augmented AVL tree.
+// Add, Delete, Has, Min, Max, Floor, Ceiling, Rank and Select run in O(log n), and Slice, Join, ForEach
+// and Iterate return the items in ascending order.
+//
+// It has the same method set as Set, so code can switch to it by changing the constructor.
+// A SortedSet must be created by NewSorted or NewSortedFunc, since the zero value has no comparator.
+// The only use of the zero value is as the target of decoding, e.g. UnmarshalJSON, for an ordered T.
+type SortedSet[T comparable] struct {
+	root *sortedNode[T]
+	cmp  func(a, b T) int
+}
+
+type sortedNode[T comparable] struct {
+	item        T
+	left, right *sortedNode[T]
+	height      int
+	size        int // the number of items in the subtree
+}
+
+// NewSorted returns a new SortedSet, which orders the items by the < operator.
+func NewSorted[T constraints.Ordered](items ...T) *SortedSet[T] {
+	return NewSortedFunc(compareOrdered[T], items...)
+}
+
+// NewSortedFunc returns a new SortedSet, which orders the items by cmp. cmp returns a negative number
+// if a < b, a positive number if a > b, and zero if a and b are the same item.
+func NewSortedFunc[T comparable](cmp func(a, b T) int, items ...T) *SortedSet[T] {
+	var s = &SortedSet[T]{cmp: cmp}
+	return s.Add(items...)
+}
+
+func compareOrdered[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ---------------------- Chained Methods ----------------------
+
+// Add adds data to SortedSet
+func (s *SortedSet[T]) Add(data ...T) *SortedSet[T] {
+	for _, item := range data {
+		s.root = s.insert(s.root, item)
+	}
+	return s
+}
+
+// Clone returns a copy of SortedSet
+func (s *SortedSet[T]) Clone() *SortedSet[T] {
+	return s.fromSorted(s.Slice())
+}
+
+// Filter filters SortedSet by f, it keeps the items that match f.
+func (s *SortedSet[T]) Filter(f func(T) bool) *SortedSet[T] {
+	var kept []T
+	s.Iterate(func(item T) bool {
+		if f(item) {
+			kept = append(kept, item)
+		}
+		return true
+	})
+	s.root = buildBalanced(kept)
+	return s
+}
+
+// Clear clears SortedSet
+func (s *SortedSet[T]) Clear() *SortedSet[T] {
+	s.root = nil
+	return s
+}
+
+// Delete deletes data from SortedSet
+func (s *SortedSet[T]) Delete(data ...T) *SortedSet[T] {
+	for _, item := range data {
+		s.root = s.remove(s.root, item)
+	}
+	return s
+}
+
+// ForEach iterates SortedSet by f in ascending order. f must not modify SortedSet.
+func (s *SortedSet[T]) ForEach(f func(T)) *SortedSet[T] {
+	s.Iterate(func(item T) bool {
+		f(item)
+		return true
+	})
+	return s
+}
+
+// Map maps SortedSet by f, the mapped items are sorted again.
+func (s *SortedSet[T]) Map(f func(T) T) *SortedSet[T] {
+	var items = s.Slice()
+	s.root = nil
+	for _, item := range items {
+		s.root = s.insert(s.root, f(item))
+	}
+	return s
+}
+
+// Merge merges SortedSet to self with other
+func (s *SortedSet[T]) Merge(other *SortedSet[T]) *SortedSet[T] {
+	other.Iterate(func(item T) bool {
+		s.root = s.insert(s.root, item)
+		return true
+	})
+	return s
+}
+
+// Intersect returns a new SortedSet, which is the intersection of SortedSet and others.
+// It iterates over the smallest operand, so intersecting a small set with a large one is fast.
+func (s *SortedSet[T]) Intersect(others ...*SortedSet[T]) *SortedSet[T] {
+	var sets = append([]*SortedSet[T]{s}, others...)
+	var smallest int
+	for i, set := range sets {
+		if set.Size() < sets[smallest].Size() {
+			smallest = i
+		}
+	}
+	var items []T
+	sets[smallest].Iterate(func(item T) bool {
+		for i, set := range sets {
+			if i != smallest && !set.Has(item) {
+				return true
+			}
+		}
+		items = append(items, item)
+		return true
+	})
+	return s.fromSorted(items)
+}
+
+// IntersectInPlace removes the items that are not in all of others from SortedSet.
+func (s *SortedSet[T]) IntersectInPlace(others ...*SortedSet[T]) *SortedSet[T] {
+	return s.Filter(func(item T) bool {
+		for _, other := range others {
+			if !other.Has(item) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Union returns a new SortedSet which is the union of SortedSet and others
+func (s *SortedSet[T]) Union(others ...*SortedSet[T]) *SortedSet[T] {
+	var newSet = s.Clone()
+	for _, other := range others {
+		newSet.Merge(other)
+	}
+	return newSet
+}
+
+// Diff returns a new SortedSet which is the difference set from SortedSet to others.
+func (s *SortedSet[T]) Diff(others ...*SortedSet[T]) *SortedSet[T] {
+	var items []T
+	s.Iterate(func(item T) bool {
+		for _, other := range others {
+			if other.Has(item) {
+				return true
+			}
+		}
+		items = append(items, item)
+		return true
+	})
+	return s.fromSorted(items)
+}
+
+// DiffInPlace removes the items in any of others from SortedSet.
+// For each other, it deletes the items of other one by one if other is smaller, otherwise it filters SortedSet.
+func (s *SortedSet[T]) DiffInPlace(others ...*SortedSet[T]) *SortedSet[T] {
+	for _, other := range others {
+		if other.Size() < s.Size() {
+			other.Iterate(func(item T) bool {
+				s.root = s.remove(s.root, item)
+				return true
+			})
+			continue
+		}
+		s.Filter(func(item T) bool {
+			return !other.Has(item)
+		})
+	}
+	return s
+}
+
+// SymmetricDiff returns a new SortedSet which has the items that are in exactly one of SortedSet and other.
+func (s *SortedSet[T]) SymmetricDiff(other *SortedSet[T]) *SortedSet[T] {
+	var newSet = s.Diff(other)
+	other.Iterate(func(item T) bool {
+		if !s.Has(item) {
+			newSet.root = newSet.insert(newSet.root, item)
+		}
+		return true
+	})
+	return newSet
+}
+
+// Range returns a new SortedSet of the items in the closed interval [lo, hi].
+func (s *SortedSet[T]) Range(lo, hi T) *SortedSet[T] {
+	var items []T
+	s.ascendRange(s.root, lo, hi, &items)
+	return s.fromSorted(items)
+}
+
+// ---------------------- Non-Chained Methods ----------------------
+
+// Min returns the least item in SortedSet. The bool is false if SortedSet is empty.
+func (s *SortedSet[T]) Min() (item T, ok bool) {
+	if s.root == nil {
+		return
+	}
+	return minNode(s.root).item, true
+}
+
+// Max returns the greatest item in SortedSet. The bool is false if SortedSet is empty.
+func (s *SortedSet[T]) Max() (item T, ok bool) {
+	if s.root == nil {
+		return
+	}
+	n := s.root
+	for n.right != nil {
+		n = n.right
+	}
+	return n.item, true
+}
+
+// PopMin removes the least item in SortedSet and returns it. The bool is false if SortedSet is empty.
+func (s *SortedSet[T]) PopMin() (item T, ok bool) {
+	if item, ok = s.Min(); ok {
+		s.root = s.remove(s.root, item)
+	}
+	return item, ok
+}
+
+// PopMax removes the greatest item in SortedSet and returns it. The bool is false if SortedSet is empty.
+func (s *SortedSet[T]) PopMax() (item T, ok bool) {
+	if item, ok = s.Max(); ok {
+		s.root = s.remove(s.root, item)
+	}
+	return item, ok
+}
+
+// Floor returns the greatest item that is less than or equal to x. The bool is false if there is no such item.
+func (s *SortedSet[T]) Floor(x T) (item T, ok bool) {
+	for n := s.root; n != nil; {
+		c := s.cmp(x, n.item)
+		switch {
+		case c == 0:
+			return n.item, true
+		case c < 0:
+			n = n.left
+		default:
+			item, ok = n.item, true
+			n = n.right
+		}
+	}
+	return item, ok
+}
+
+// Ceiling returns the least item that is greater than or equal to x. The bool is false if there is no such item.
+func (s *SortedSet[T]) Ceiling(x T) (item T, ok bool) {
+	for n := s.root; n != nil; {
+		c := s.cmp(x, n.item)
+		switch {
+		case c == 0:
+			return n.item, true
+		case c > 0:
+			n = n.right
+		default:
+			item, ok = n.item, true
+			n = n.left
+		}
+	}
+	return item, ok
+}
+
+// Rank returns the number of items that are less than x, which is the index of x if it is present.
+func (s *SortedSet[T]) Rank(x T) int {
+	var rank int
+	for n := s.root; n != nil; {
+		c := s.cmp(x, n.item)
+		switch {
+		case c == 0:
+			return rank + sizeOf(n.left)
+		case c < 0:
+			n = n.left
+		default:
+			rank += sizeOf(n.left) + 1
+			n = n.right
+		}
+	}
+	return rank
+}
+
+// Select returns the k-th least item (0-based). The bool is false if k is out of range.
+func (s *SortedSet[T]) Select(k int) (item T, ok bool) {
+	if k < 0 || k >= s.Size() {
+		return
+	}
+	for n := s.root; ; {
+		left := sizeOf(n.left)
+		switch {
+		case k < left:
+			n = n.left
+		case k > left:
+			k -= left + 1
+			n = n.right
+		default:
+			return n.item, true
+		}
+	}
+}
+
+// IsSubsetOf checks if SortedSet is a subset of other
+func (s *SortedSet[T]) IsSubsetOf(other *SortedSet[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	var subset = true
+	s.Iterate(func(item T) bool {
+		subset = other.Has(item)
+		return subset
+	})
+	return subset
+}
+
+// IsProperSubsetOf checks if SortedSet is a subset of other, and other has more items than SortedSet
+func (s *SortedSet[T]) IsProperSubsetOf(other *SortedSet[T]) bool {
+	return s.Size() < other.Size() && s.IsSubsetOf(other)
+}
+
+// IsSupersetOf checks if SortedSet is a superset of other
+func (s *SortedSet[T]) IsSupersetOf(other *SortedSet[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsProperSupersetOf checks if SortedSet is a superset of other, and SortedSet has more items than other
+func (s *SortedSet[T]) IsProperSupersetOf(other *SortedSet[T]) bool {
+	return other.IsProperSubsetOf(s)
+}
+
+// IsDisjoint checks if SortedSet and other have no items in common. It iterates over the smaller one.
+func (s *SortedSet[T]) IsDisjoint(other *SortedSet[T]) bool {
+	var small, large = s, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	var disjoint = true
+	small.Iterate(func(item T) bool {
+		disjoint = !large.Has(item)
+		return disjoint
+	})
+	return disjoint
+}
+
+// Slice returns a slice of SortedSet in ascending order
+func (s *SortedSet[T]) Slice() (copied []T) {
+	s.Iterate(func(item T) bool {
+		copied = append(copied, item)
+		return true
+	})
+	return copied
+}
+
+// Size returns the size of SortedSet
+func (s *SortedSet[T]) Size() int {
+	return sizeOf(s.root)
+}
+
+// Has checks if SortedSet contains item
+func (s *SortedSet[T]) Has(item T) bool {
+	for n := s.root; n != nil; {
+		c := s.cmp(item, n.item)
+		switch {
+		case c == 0:
+			return true
+		case c < 0:
+			n = n.left
+		default:
+			n = n.right
+		}
+	}
+	return false
+}
+
+// Equal checks if SortedSet is equal to other
+func (s *SortedSet[T]) Equal(other *SortedSet[T]) bool {
+	return s.Size() == other.Size() && s.IsSubsetOf(other)
+}
+
+// Join joins SortedSet by sep in ascending order
+func (s *SortedSet[T]) Join(sep string) string {
+	var ss []string
+	s.Iterate(func(item T) bool {
+		ss = append(ss, fmt.Sprintf("%v", item))
+		return true
+	})
+	return strings.Join(ss, sep)
+}
+
+// IsEmpty checks if SortedSet is empty
+func (s *SortedSet[T]) IsEmpty() bool {
+	return s.root == nil
+}
+
+// Iterate calls f for each item in SortedSet in ascending order. If f returns false, Iterate stops the iteration.
+// f must not modify SortedSet.
+func (s *SortedSet[T]) Iterate(f func(T) bool) {
+	var stack []*sortedNode[T]
+	for n := s.root; n != nil || len(stack) > 0; n = n.right {
+		for ; n != nil; n = n.left {
+			stack = append(stack, n)
+		}
+		n = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !f(n.item) {
+			return
+		}
+	}
+}
+
+// ---------------------- internal ----------------------
+
+// fromSorted returns a new SortedSet with the same comparator, built from items in ascending order in O(n).
+func (s *SortedSet[T]) fromSorted(items []T) *SortedSet[T] {
+	return &SortedSet[T]{root: buildBalanced(items), cmp: s.cmp}
+}
+
+func (s *SortedSet[T]) ascendRange(n *sortedNode[T], lo, hi T, items *[]T) {
+	if n == nil {
+		return
+	}
+	cLo, cHi := s.cmp(n.item, lo), s.cmp(n.item, hi)
+	if cLo > 0 {
+		s.ascendRange(n.left, lo, hi, items)
+	}
+	if cLo >= 0 && cHi <= 0 {
+		*items = append(*items, n.item)
+	}
+	if cHi < 0 {
+		s.ascendRange(n.right, lo, hi, items)
+	}
+}
+
+func (s *SortedSet[T]) insert(n *sortedNode[T], item T) *sortedNode[T] {
+	if n == nil {
+		return &sortedNode[T]{item: item, height: 1, size: 1}
+	}
+	c := s.cmp(item, n.item)
+	switch {
+	case c < 0:
+		n.left = s.insert(n.left, item)
+	case c > 0:
+		n.right = s.insert(n.right, item)
+	default:
+		return n
+	}
+	return rebalance(n)
+}
+
+func (s *SortedSet[T]) remove(n *sortedNode[T], item T) *sortedNode[T] {
+	if n == nil {
+		return nil
+	}
+	c := s.cmp(item, n.item)
+	switch {
+	case c < 0:
+		n.left = s.remove(n.left, item)
+	case c > 0:
+		n.right = s.remove(n.right, item)
+	default:
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		successor := minNode(n.right)
+		n.item = successor.item
+		n.right = removeMin(n.right)
+	}
+	return rebalance(n)
+}
+
+func minNode[T comparable](n *sortedNode[T]) *sortedNode[T] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func removeMin[T comparable](n *sortedNode[T]) *sortedNode[T] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = removeMin(n.left)
+	return rebalance(n)
+}
+
+// buildBalanced builds a balanced tree from items in ascending order.
+func buildBalanced[T comparable](items []T) *sortedNode[T] {
+	if len(items) == 0 {
+		return nil
+	}
+	mid := len(items) / 2
+	var n = &sortedNode[T]{item: items[mid], left: buildBalanced(items[:mid]), right: buildBalanced(items[mid+1:])}
+	updateNode(n)
+	return n
+}
+
+func sizeOf[T comparable](n *sortedNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func heightOf[T comparable](n *sortedNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func updateNode[T comparable](n *sortedNode[T]) {
+	n.height = heightOf(n.left) + 1
+	if h := heightOf(n.right) + 1; h > n.height {
+		n.height = h
+	}
+	n.size = sizeOf(n.left) + sizeOf(n.right) + 1
+}
+
+// rebalance updates n and rotates it if its subtrees differ in height by more than one.
+func rebalance[T comparable](n *sortedNode[T]) *sortedNode[T] {
+	updateNode(n)
+	switch balance := heightOf(n.left) - heightOf(n.right); {
+	case balance > 1:
+		if heightOf(n.left.left) < heightOf(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if heightOf(n.right.right) < heightOf(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	default:
+		return n
+	}
+}
+
+func rotateLeft[T comparable](n *sortedNode[T]) *sortedNode[T] {
+	r := n.right
+	n.right, r.left = r.left, n
+	updateNode(n)
+	updateNode(r)
+	return r
+}
+
+func rotateRight[T comparable](n *sortedNode[T]) *sortedNode[T] {
+	l := n.left
+	n.left, l.right = l.right, n
+	updateNode(n)
+	updateNode(l)
+	return l
+}
@@ -0,0 +1,218 @@
+package sset
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// checkAVL checks the AVL invariants of the subtree, and returns its items in order.
+func checkAVL[T comparable](t *testing.T, s *SortedSet[T], n *sortedNode[T]) []T {
+	if n == nil {
+		return nil
+	}
+	left, right := checkAVL(t, s, n.left), checkAVL(t, s, n.right)
+	balance := heightOf(n.left) - heightOf(n.right)
+	assert.True(t, balance >= -1 && balance <= 1, "unbalanced node")
+	assert.Equal(t, sizeOf(n.left)+sizeOf(n.right)+1, n.size)
+	if len(left) > 0 {
+		assert.Less(t, s.cmp(left[len(left)-1], n.item), 0)
+	}
+	if len(right) > 0 {
+		assert.Greater(t, s.cmp(right[0], n.item), 0)
+	}
+	return append(append(left, n.item), right...)
+}
+
+func TestSortedSet_RandomOperations_MatchReference(t *testing.T) {
+	var r = rand.New(rand.NewSource(1))
+	var s = NewSorted[int]()
+	var ref = make(map[int]bool)
+	for i := 0; i < 3000; i++ {
+		v := r.Intn(500)
+		if r.Intn(3) == 0 {
+			s.Delete(v)
+			delete(ref, v)
+		} else {
+			s.Add(v)
+			ref[v] = true
+		}
+	}
+	var expected []int
+	for v := range ref {
+		expected = append(expected, v)
+	}
+	sort.Ints(expected)
+
+	assert.Equal(t, expected, checkAVL(t, s, s.root))
+	assert.Equal(t, expected, s.Slice())
+	assert.Equal(t, len(expected), s.Size())
+	for k, v := range expected {
+		assert.Equal(t, k, s.Rank(v))
+		got, ok := s.Select(k)
+		assert.True(t, ok)
+		assert.Equal(t, v, got)
+		assert.True(t, s.Has(v))
+	}
+	for x := -1; x <= 501; x++ {
+		assert.Equal(t, sort.SearchInts(expected, x), s.Rank(x))
+		assert.Equal(t, ref[x], s.Has(x))
+	}
+}
+
+func TestSortedSet_MinMaxPop(t *testing.T) {
+	s := NewSorted[int]()
+	_, ok := s.Min()
+	assert.False(t, ok)
+	_, ok = s.Max()
+	assert.False(t, ok)
+	_, ok = s.PopMin()
+	assert.False(t, ok)
+	_, ok = s.PopMax()
+	assert.False(t, ok)
+
+	s.Add(5, 1, 9, 3)
+	min, _ := s.Min()
+	max, _ := s.Max()
+	assert.Equal(t, 1, min)
+	assert.Equal(t, 9, max)
+
+	min, _ = s.PopMin()
+	max, _ = s.PopMax()
+	assert.Equal(t, 1, min)
+	assert.Equal(t, 9, max)
+	assert.Equal(t, []int{3, 5}, s.Slice())
+}
+
+func TestSortedSet_FloorCeiling(t *testing.T) {
+	s := NewSorted(10, 20, 30)
+	cases := []struct {
+		x                 int
+		floor, ceiling    int
+		hasFloor, hasCeil bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{15, 10, 20, true, true},
+		{30, 30, 30, true, true},
+		{35, 30, 0, true, false},
+	}
+	for _, c := range cases {
+		floor, ok := s.Floor(c.x)
+		assert.Equal(t, c.hasFloor, ok, c.x)
+		assert.Equal(t, c.floor, floor, c.x)
+		ceiling, ok := s.Ceiling(c.x)
+		assert.Equal(t, c.hasCeil, ok, c.x)
+		assert.Equal(t, c.ceiling, ceiling, c.x)
+	}
+}
+
+func TestSortedSet_RangeAndSelect(t *testing.T) {
+	s := NewSorted(1, 3, 5, 7, 9)
+	assert.Equal(t, []int{3, 5, 7}, s.Range(2, 7).Slice())
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, s.Range(0, 100).Slice())
+	assert.Nil(t, s.Range(4, 4).Slice())
+	assert.Nil(t, s.Range(9, 1).Slice())
+
+	sub := s.Range(3, 7)
+	checkAVL(t, sub, sub.root)
+	sub.Add(4)
+	assert.Equal(t, []int{3, 4, 5, 7}, sub.Slice())
+	assert.False(t, s.Has(4))
+
+	_, ok := s.Select(-1)
+	assert.False(t, ok)
+	_, ok = s.Select(5)
+	assert.False(t, ok)
+}
+
+func TestSortedSet_SetMethods(t *testing.T) {
+	s := NewSorted("pear", "apple", "fig")
+	assert.Equal(t, "apple,fig,pear", s.Join(","))
+	assert.False(t, s.IsEmpty())
+
+	s.Filter(func(v string) bool { return v != "fig" })
+	assert.Equal(t, []string{"apple", "pear"}, s.Slice())
+	checkAVL(t, s, s.root)
+
+	s.Map(strings.ToUpper)
+	assert.Equal(t, []string{"APPLE", "PEAR"}, s.Slice())
+
+	var visited []string
+	s.ForEach(func(v string) { visited = append(visited, v) })
+	assert.Equal(t, []string{"APPLE", "PEAR"}, visited)
+
+	cp := s.Clone()
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, 2, cp.Size())
+}
+
+func TestSortedSet_Algebra(t *testing.T) {
+	a := NewSorted(4, 3, 2, 1)
+	b := NewSorted(2, 4, 6)
+	assert.Equal(t, []int{2, 4}, a.Intersect(b).Slice())
+	assert.Equal(t, []int{1, 3}, a.Diff(b).Slice())
+	assert.Equal(t, []int{1, 2, 3, 4, 6, 8}, a.Union(b, NewSorted(8)).Slice())
+	assert.True(t, NewSorted(2, 4).IsSubsetOf(a))
+	assert.False(t, b.IsSubsetOf(a))
+	assert.True(t, a.Equal(NewSorted(1, 2, 3, 4)))
+	assert.False(t, a.Equal(b))
+
+	a.Merge(b)
+	assert.Equal(t, []int{1, 2, 3, 4, 6}, a.Slice())
+}
+
+func TestSortedSet_Iterate_StopsEarly(t *testing.T) {
+	s := NewSorted(3, 1, 2)
+	var visited []int
+	s.Iterate(func(v int) bool {
+		visited = append(visited, v)
+		return v < 2
+	})
+	assert.Equal(t, []int{1, 2}, visited)
+}
+
+func TestSortedSet_Comparator(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+	byAge := func(a, b user) int { return a.Age - b.Age }
+	s := NewSortedFunc(byAge, user{"b", 30}, user{"a", 20}, user{"c", 40})
+	youngest, _ := s.Min()
+	assert.Equal(t, "a", youngest.Name)
+	assert.Equal(t, 1, s.Rank(user{Age: 30}))
+	assert.True(t, s.Has(user{Age: 40}))
+
+	s.Add(user{"d", 30}) // the same item as b by the comparator
+	assert.Equal(t, 3, s.Size())
+
+	ranged := s.Range(user{Age: 25}, user{Age: 50})
+	assert.Equal(t, []user{{"b", 30}, {"c", 40}}, ranged.Slice())
+	ranged.Add(user{"e", 35})
+	assert.Equal(t, 1, ranged.Rank(user{Age: 35}))
+}
+
+func TestSortedSet_JSON(t *testing.T) {
+	data, err := json.Marshal(NewSorted(3, 1, 2))
+	assert.Nil(t, err)
+	assert.Equal(t, `[1,2,3]`, string(data))
+
+	var config struct {
+		IDs *SortedSet[int] `json:"ids"`
+	}
+	assert.Nil(t, json.Unmarshal([]byte(`{"ids":[9,7,8,7]}`), &config))
+	assert.Equal(t, []int{7, 8, 9}, config.IDs.Slice())
+
+	type point struct{ X int }
+	var points SortedSet[point]
+	assert.NotNil(t, json.Unmarshal([]byte(`[{"X":1}]`), &points))
+
+	byX := NewSortedFunc(func(a, b point) int { return a.X - b.X })
+	assert.Nil(t, json.Unmarshal([]byte(`[{"X":2},{"X":1}]`), byX))
+	assert.Equal(t, []point{{1}, {2}}, byX.Slice())
+}
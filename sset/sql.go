@@ -0,0 +1,117 @@
+package sset
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"github.com/chaseSpace/bear/internal/pgarray"
+)
+
+// Value implements driver.Valuer, so that Set can be passed to sql.DB.Exec and friends.
+// It encodes Set as a JSON array, which is sorted if T is ordered, see MarshalJSON.
+// A nil Set is encoded as NULL. Use AsPgArray for Postgres array columns.
+func (s *Set[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner, so that Set can be passed to sql.Rows.Scan and friends.
+// It accepts a JSON array, or a Postgres array literal like {a,b,c}, and replaces the items in Set.
+// NULL makes Set empty.
+func (s *Set[T]) Scan(src interface{}) error {
+	var text string
+	switch src := src.(type) {
+	case nil:
+		s.reset(nil)
+		return nil
+	case []byte:
+		text = string(src)
+	case string:
+		text = src
+	default:
+		return fmt.Errorf("cannot scan %T into Set", src)
+	}
+	if len(text) > 0 && text[0] == '{' {
+		items, err := pgarray.Parse[T](text)
+		if err != nil {
+			return err
+		}
+		s.reset(items)
+		return nil
+	}
+	return s.UnmarshalJSON([]byte(text))
+}
+
+// PgArray adapts Set to a Postgres array column, see AsPgArray.
+type PgArray[T comparable] struct {
+	set *Set[T]
+}
+
+// AsPgArray returns an adapter that encodes s as a Postgres array literal like {"a","b"}, sorted if T is
+// ordered, and decodes such literals into s. A nil s is encoded as NULL.
+// The element type must be a string, integer, float or bool type.
+//
+//	db.Exec("INSERT INTO t (tags) VALUES ($1)", sset.AsPgArray(tags))
+//	row.Scan(sset.AsPgArray(tags))
+func AsPgArray[T comparable](s *Set[T]) PgArray[T] {
+	return PgArray[T]{set: s}
+}
+
+// Value implements driver.Valuer.
+func (a PgArray[T]) Value() (driver.Value, error) {
+	if a.set == nil {
+		return nil, nil
+	}
+	return pgarray.Format(a.set.sortedSlice())
+}
+
+// Scan implements sql.Scanner. It replaces the items in the adapted Set, and NULL makes it empty.
+func (a PgArray[T]) Scan(src interface{}) error {
+	if a.set == nil {
+		return fmt.Errorf("cannot scan into nil Set")
+	}
+	return a.set.Scan(src)
+}
+
+// Value implements driver.Valuer, so that SortedSet can be passed to sql.DB.Exec and friends.
+// It encodes SortedSet as a JSON array in ascending order. A nil SortedSet is encoded as NULL.
+func (s *SortedSet[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner, so that SortedSet can be passed to sql.Rows.Scan and friends.
+// It accepts a JSON array, or a Postgres array literal like {a,b,c}, and replaces the items in SortedSet.
+// NULL makes SortedSet empty.
+func (s *SortedSet[T]) Scan(src interface{}) error {
+	var text string
+	switch src := src.(type) {
+	case nil:
+		return s.reset(nil)
+	case []byte:
+		text = string(src)
+	case string:
+		text = src
+	default:
+		return fmt.Errorf("cannot scan %T into SortedSet", src)
+	}
+	if len(text) > 0 && text[0] == '{' {
+		items, err := pgarray.Parse[T](text)
+		if err != nil {
+			return err
+		}
+		return s.reset(items)
+	}
+	return s.UnmarshalJSON([]byte(text))
+}
@@ -0,0 +1,112 @@
+package sset
+
+import (
+	"database/sql"
+	"github.com/chaseSpace/bear/internal/fakesql"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSet_SQL_JSON(t *testing.T) {
+	db, err := fakesql.Open()
+	assert.Nil(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("SET", "tags", New("b", "c", "a"))
+	assert.Nil(t, err)
+	var raw string
+	assert.Nil(t, db.QueryRow("GET", "tags").Scan(&raw))
+	assert.Equal(t, `["a","b","c"]`, raw)
+
+	var tags = New[string]()
+	assert.Nil(t, db.QueryRow("GET", "tags").Scan(tags))
+	assert.True(t, tags.Equal(New("a", "b", "c")))
+}
+
+func TestSet_SQL_PgArray(t *testing.T) {
+	db, err := fakesql.Open()
+	assert.Nil(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("SET", "ids", AsPgArray(New(3, 1, 2)))
+	assert.Nil(t, err)
+	var raw string
+	assert.Nil(t, db.QueryRow("GET", "ids").Scan(&raw))
+	assert.Equal(t, `{1,2,3}`, raw)
+
+	var ids = New[int]()
+	assert.Nil(t, db.QueryRow("GET", "ids").Scan(AsPgArray(ids)))
+	assert.True(t, ids.Equal(New(1, 2, 3)))
+}
+
+func TestSet_SQL_Null(t *testing.T) {
+	db, err := fakesql.Open()
+	assert.Nil(t, err)
+	defer db.Close()
+
+	var nilSet *Set[string]
+	_, err = db.Exec("SET", "a", nilSet)
+	assert.Nil(t, err)
+	_, err = db.Exec("SET", "b", AsPgArray(nilSet))
+	assert.Nil(t, err)
+
+	for _, key := range []string{"a", "b"} {
+		var raw sql.NullString
+		assert.Nil(t, db.QueryRow("GET", key).Scan(&raw))
+		assert.False(t, raw.Valid)
+
+		var tags = New("x")
+		assert.Nil(t, db.QueryRow("GET", key).Scan(tags))
+		assert.True(t, tags.IsEmpty())
+		tags.Add("y")
+		assert.True(t, tags.Has("y"))
+	}
+}
+
+func TestSet_Scan(t *testing.T) {
+	var s = New[string]()
+	assert.Nil(t, s.Scan(`{a,a,"b"}`))
+	assert.True(t, s.Equal(New("a", "b")))
+
+	assert.NotNil(t, s.Scan(1.5))
+	assert.NotNil(t, s.Scan(`{NULL}`))
+	assert.NotNil(t, AsPgArray[string](nil).Scan(`{a}`))
+}
+
+func TestSortedSet_SQL(t *testing.T) {
+	db, err := fakesql.Open()
+	assert.Nil(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("SET", "ids", NewSorted(3, 1, 2))
+	assert.Nil(t, err)
+	var raw string
+	assert.Nil(t, db.QueryRow("GET", "ids").Scan(&raw))
+	assert.Equal(t, `[1,2,3]`, raw)
+
+	var ids = NewSorted(9)
+	assert.Nil(t, db.QueryRow("GET", "ids").Scan(ids))
+	assert.Equal(t, []int{1, 2, 3}, ids.Slice())
+
+	// a zero SortedSet of an ordered type can be scanned into, like it can be decoded into
+	var zero SortedSet[int]
+	assert.Nil(t, db.QueryRow("GET", "ids").Scan(&zero))
+	assert.Equal(t, []int{1, 2, 3}, zero.Slice())
+
+	var nilSet *SortedSet[int]
+	_, err = db.Exec("SET", "null", nilSet)
+	assert.Nil(t, err)
+	assert.Nil(t, db.QueryRow("GET", "null").Scan(ids))
+	assert.True(t, ids.IsEmpty())
+}
+
+func TestSortedSet_Scan(t *testing.T) {
+	var s = NewSorted[string]()
+	assert.Nil(t, s.Scan(`{c,a,a,"b"}`))
+	assert.Equal(t, []string{"a", "b", "c"}, s.Slice())
+	assert.Nil(t, s.Scan([]byte(`["z","y"]`)))
+	assert.Equal(t, []string{"y", "z"}, s.Slice())
+
+	assert.NotNil(t, s.Scan(1.5))
+	assert.NotNil(t, s.Scan(`{NULL}`))
+}
@@ -0,0 +1,190 @@
+package sslice
+
+import (
+	"encoding/json"
+	"github.com/chaseSpace/bear/constraints"
+	"gopkg.in/yaml.v3"
+	"sort"
+)
+
+// The slices are encoded as a plain list of their elements, in order.
+// JSON, YAML and text (which is the same as JSON) are supported, so that a slice can be a field of
+// a config or API struct.
+
+// MarshalJSON implements json.Marshaler.
+func (s *Slice[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.items())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the elements in Slice.
+func (s *Slice[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	s.data = New(items...).data
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s *Slice[T]) MarshalYAML() (interface{}, error) {
+	return s.items(), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the elements in Slice.
+func (s *Slice[T]) UnmarshalYAML(value *yaml.Node) error {
+	var items []T
+	if err := value.Decode(&items); err != nil {
+		return err
+	}
+	s.data = New(items...).data
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of Slice.
+func (s *Slice[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of Slice.
+func (s *Slice[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// items returns the elements, which are never nil, so that an empty Slice is encoded as an empty list.
+func (s *Slice[T]) items() []T {
+	if s.data == nil {
+		return []T{}
+	}
+	return s.data
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *OrderedSlice[T]) MarshalJSON() ([]byte, error) {
+	return s.inner().MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the elements in OrderedSlice.
+func (s *OrderedSlice[T]) UnmarshalJSON(data []byte) error {
+	return s.inner().UnmarshalJSON(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s *OrderedSlice[T]) MarshalYAML() (interface{}, error) {
+	return s.inner().MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the elements in OrderedSlice.
+func (s *OrderedSlice[T]) UnmarshalYAML(value *yaml.Node) error {
+	return s.inner().UnmarshalYAML(value)
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of OrderedSlice.
+func (s *OrderedSlice[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of OrderedSlice.
+func (s *OrderedSlice[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// inner returns the underlying Slice, creating it for a zero OrderedSlice.
+func (s *OrderedSlice[T]) inner() *Slice[T] {
+	if s.slice == nil {
+		s.slice = New[T]()
+	}
+	return s.slice
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *ComputableSlice[T]) MarshalJSON() ([]byte, error) {
+	return s.inner().MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the elements in ComputableSlice.
+func (s *ComputableSlice[T]) UnmarshalJSON(data []byte) error {
+	return s.inner().UnmarshalJSON(data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s *ComputableSlice[T]) MarshalYAML() (interface{}, error) {
+	return s.inner().MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the elements in ComputableSlice.
+func (s *ComputableSlice[T]) UnmarshalYAML(value *yaml.Node) error {
+	return s.inner().UnmarshalYAML(value)
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of ComputableSlice.
+func (s *ComputableSlice[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of ComputableSlice.
+func (s *ComputableSlice[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// inner returns the underlying Slice, creating it for a zero ComputableSlice.
+func (s *ComputableSlice[T]) inner() *Slice[T] {
+	if s.slice == nil {
+		s.slice = New[T]()
+	}
+	return s.slice
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s *SortedSlice[T]) MarshalJSON() ([]byte, error) {
+	return s.inner().MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It replaces the elements in SortedSlice,
+// and sorts them, so the input does not need to be sorted.
+func (s *SortedSlice[T]) UnmarshalJSON(data []byte) error {
+	if err := s.inner().UnmarshalJSON(data); err != nil {
+		return err
+	}
+	sortAsc(s.slice.data)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (s *SortedSlice[T]) MarshalYAML() (interface{}, error) {
+	return s.inner().MarshalYAML()
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler. It replaces the elements in SortedSlice,
+// and sorts them, so the input does not need to be sorted.
+func (s *SortedSlice[T]) UnmarshalYAML(value *yaml.Node) error {
+	if err := s.inner().UnmarshalYAML(value); err != nil {
+		return err
+	}
+	sortAsc(s.slice.data)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the JSON encoding of SortedSlice.
+func (s *SortedSlice[T]) MarshalText() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the JSON encoding of SortedSlice.
+func (s *SortedSlice[T]) UnmarshalText(text []byte) error {
+	return s.UnmarshalJSON(text)
+}
+
+// inner returns the underlying Slice, creating it for a zero SortedSlice.
+func (s *SortedSlice[T]) inner() *Slice[T] {
+	if s.slice == nil {
+		s.slice = New[T]()
+	}
+	return s.slice
+}
+
+func sortAsc[T constraints.Ordered](data []T) {
+	sort.Slice(data, func(i, j int) bool {
+		return data[i] < data[j]
+	})
+}
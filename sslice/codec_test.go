@@ -0,0 +1,98 @@
+package sslice
+
+import (
+	"encoding"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+type codecConfig struct {
+	Names  *Slice[string]            `json:"names" yaml:"names"`
+	Ranks  *OrderedSlice[int]        `json:"ranks" yaml:"ranks"`
+	Scores *ComputableSlice[float64] `json:"scores" yaml:"scores"`
+	Sorted *SortedSlice[int]         `json:"sorted" yaml:"sorted"`
+}
+
+func TestSlice_JSON(t *testing.T) {
+	var config = codecConfig{
+		Names:  New("b", "a"),
+		Ranks:  NewOrderedSlice(3, 1),
+		Scores: NewComputableSlice(1.5),
+		Sorted: NewSortedSlice(2, 1),
+	}
+	data, err := json.Marshal(config)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"names":["b","a"],"ranks":[3,1],"scores":[1.5],"sorted":[1,2]}`, string(data))
+
+	var decoded codecConfig
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, []string{"b", "a"}, decoded.Names.Slice())
+	assert.Equal(t, []int{3, 1}, decoded.Ranks.Slice())
+	assert.Equal(t, []float64{1.5}, decoded.Scores.Slice())
+	assert.Equal(t, []int{1, 2}, decoded.Sorted.Slice())
+}
+
+func TestSlice_JSON_EmptyAndNull(t *testing.T) {
+	data, err := json.Marshal(New[int]())
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	data, err = json.Marshal(&Slice[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	var s = New(1, 2)
+	assert.Nil(t, json.Unmarshal([]byte(`null`), s))
+	assert.True(t, s.IsEmpty())
+
+	assert.NotNil(t, json.Unmarshal([]byte(`["x"]`), s))
+}
+
+func TestSlice_JSON_ReplacesElements(t *testing.T) {
+	var s = New(1, 2, 3)
+	assert.Nil(t, json.Unmarshal([]byte(`[4]`), s))
+	assert.Equal(t, []int{4}, s.Slice())
+}
+
+func TestSortedSlice_UnmarshalJSON_SortsUnsortedInput(t *testing.T) {
+	var s SortedSlice[int]
+	assert.Nil(t, json.Unmarshal([]byte(`[3,1,2]`), &s))
+	assert.Equal(t, []int{1, 2, 3}, s.Slice())
+	assert.True(t, s.Contains(2))
+}
+
+func TestSlice_YAML(t *testing.T) {
+	var config = codecConfig{
+		Names:  New("b", "a"),
+		Ranks:  NewOrderedSlice(3, 1),
+		Scores: NewComputableSlice(1.5),
+		Sorted: NewSortedSlice(2, 1),
+	}
+	data, err := yaml.Marshal(config)
+	assert.Nil(t, err)
+	assert.Equal(t, "names:\n    - b\n    - a\nranks:\n    - 3\n    - 1\nscores:\n    - 1.5\nsorted:\n    - 1\n    - 2\n", string(data))
+
+	var decoded codecConfig
+	assert.Nil(t, yaml.Unmarshal([]byte("names: [x]\nranks: [2, 1]\nscores: [0.5]\nsorted: [9, 8]\n"), &decoded))
+	assert.Equal(t, []string{"x"}, decoded.Names.Slice())
+	assert.Equal(t, []int{2, 1}, decoded.Ranks.Slice())
+	assert.Equal(t, []float64{0.5}, decoded.Scores.Slice())
+	assert.Equal(t, []int{8, 9}, decoded.Sorted.Slice())
+
+	assert.NotNil(t, yaml.Unmarshal([]byte("ranks: [a]\n"), &decoded))
+}
+
+func TestSlice_Text(t *testing.T) {
+	var _ encoding.TextMarshaler = New[int]()
+	var _ encoding.TextUnmarshaler = New[int]()
+
+	text, err := New("a", "b").MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, `["a","b"]`, string(text))
+
+	var s OrderedSlice[string]
+	assert.Nil(t, s.UnmarshalText(text))
+	assert.Equal(t, []string{"a", "b"}, s.Slice())
+}
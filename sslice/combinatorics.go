@@ -0,0 +1,52 @@
+package sslice
+
+import "github.com/chaseSpace/bear/internal/combin"
+
+// The functions below return lazy iterators, because their outputs grow exponentially.
+// An iterator yields a new Slice for each tuple, in lexicographic order of the element positions,
+// and stops when yield returns false. Each iteration reads the elements of s when it starts.
+// The iterators can be ranged over with Go 1.23+, or passed to stream.From.
+//
+// The elements are told apart by their positions, not their values, so equal elements produce
+// repeated tuples, like Python's itertools.
+
+// Permutations returns an iterator over the permutations of all the elements in s.
+func Permutations[T comparable](s *Slice[T]) func(yield func(*Slice[T]) bool) {
+	return func(yield func(*Slice[T]) bool) {
+		var data = s.Slice()
+		combin.Permutations(len(data), func(idx []int) bool {
+			return yield(pick(data, idx))
+		})
+	}
+}
+
+// Combinations returns an iterator over the combinations of k elements in s.
+// It yields nothing if k is negative or greater than the length of s.
+func Combinations[T comparable](s *Slice[T], k int) func(yield func(*Slice[T]) bool) {
+	return func(yield func(*Slice[T]) bool) {
+		var data = s.Slice()
+		combin.Combinations(len(data), k, func(idx []int) bool {
+			return yield(pick(data, idx))
+		})
+	}
+}
+
+// CombinationsWithReplacement returns an iterator over the combinations of k elements in s,
+// where an element can be picked more than once.
+func CombinationsWithReplacement[T comparable](s *Slice[T], k int) func(yield func(*Slice[T]) bool) {
+	return func(yield func(*Slice[T]) bool) {
+		var data = s.Slice()
+		combin.CombinationsWithReplacement(len(data), k, func(idx []int) bool {
+			return yield(pick(data, idx))
+		})
+	}
+}
+
+// pick returns a new Slice of the elements of data at idx.
+func pick[T comparable](data []T, idx []int) *Slice[T] {
+	var picked = make([]T, len(idx))
+	for i, j := range idx {
+		picked[i] = data[j]
+	}
+	return &Slice[T]{data: picked}
+}
@@ -0,0 +1,65 @@
+package sslice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func collectSlices[T comparable](seq func(yield func(*Slice[T]) bool)) [][]T {
+	var result [][]T
+	seq(func(s *Slice[T]) bool {
+		result = append(result, s.Slice())
+		return true
+	})
+	return result
+}
+
+func TestPermutations(t *testing.T) {
+	assert.Equal(t, [][]string{{"a", "b", "c"}, {"a", "c", "b"}, {"b", "a", "c"}, {"b", "c", "a"}, {"c", "a", "b"}, {"c", "b", "a"}},
+		collectSlices(Permutations(New("a", "b", "c"))))
+	assert.Equal(t, [][]int{{}}, collectSlices(Permutations(New[int]())))
+	assert.Equal(t, [][]int{{1, 1}, {1, 1}}, collectSlices(Permutations(New(1, 1))))
+}
+
+func TestCombinations(t *testing.T) {
+	s := New(1, 2, 3, 4)
+	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}, collectSlices(Combinations(s, 2)))
+	assert.Equal(t, [][]int{{}}, collectSlices(Combinations(s, 0)))
+	assert.Nil(t, collectSlices(Combinations(s, 5)))
+	assert.Nil(t, collectSlices(Combinations(s, -1)))
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	assert.Equal(t, [][]string{{"x", "x"}, {"x", "y"}, {"y", "y"}},
+		collectSlices(CombinationsWithReplacement(New("x", "y"), 2)))
+	assert.Nil(t, collectSlices(CombinationsWithReplacement(New[string](), 1)))
+}
+
+func TestCombinatorics_Lazy(t *testing.T) {
+	s := New(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)
+	var count int
+	Permutations(s)(func(p *Slice[int]) bool {
+		count++
+		return count < 5
+	})
+	assert.Equal(t, 5, count)
+
+	// each tuple is a new Slice, which can be retained and modified
+	var first *Slice[int]
+	Combinations(s, 3)(func(c *Slice[int]) bool {
+		if first == nil {
+			first = c
+		}
+		c.Append(0)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3, 0}, first.Slice())
+	assert.Equal(t, 12, s.Len())
+}
+
+func TestCombinatorics_ReadElementsWhenIterationStarts(t *testing.T) {
+	s := New(1, 2)
+	seq := Combinations(s, 2)
+	s.Append(3)
+	assert.Len(t, collectSlices(seq), 3)
+}
@@ -2,7 +2,7 @@ package sslice
 
 import (
 	"github.com/chaseSpace/bear/constraints"
-	"sort"
+	"math/big"
 )
 
 type ComputableSlice[T constraints.Computable] struct {
@@ -14,18 +14,16 @@ func NewComputableSlice[T constraints.Computable](items ...T) *ComputableSlice[T
 }
 
 // Sort sorts the elements in ComputableSlice in ascending order by default. If true is passed,
-// it sorts in descending order.
+// it sorts in descending order. The sort is not stable; use SortStable to keep the order of equal elements,
+// like +0 and -0.
 func (s *ComputableSlice[T]) Sort(desc ...bool) *ComputableSlice[T] {
-	if len(desc) > 0 && desc[0] { // desc sort
-		sort.Slice(s.slice.data, func(i, j int) bool {
-			return s.slice.data[i] > s.slice.data[j]
-		})
-		return s
-	}
-	// asc sort
-	sort.Slice(s.slice.data, func(i, j int) bool {
-		return s.slice.data[i] < s.slice.data[j]
-	})
+	sortOrdered(s.slice.data, len(desc) > 0 && desc[0], false)
+	return s
+}
+
+// SortStable sorts the elements in ComputableSlice like Sort, keeping the original order of equal elements.
+func (s *ComputableSlice[T]) SortStable(desc ...bool) *ComputableSlice[T] {
+	sortOrdered(s.slice.data, len(desc) > 0 && desc[0], true)
 	return s
 }
 
@@ -85,7 +83,8 @@ func (s *ComputableSlice[T]) PopRight() *ComputableSlice[T] {
 // ------------------ split line ------------------------
 // - Below are non-chain methods.
 
-// Sum returns the sum of all elements in the slice.
+// Sum returns the sum of all elements in the slice. It wraps around silently on integer overflow,
+// use SumChecked or SumWide if that may happen.
 func (s *ComputableSlice[T]) Sum() T {
 	var sum T
 	for _, item := range s.slice.data {
@@ -94,24 +93,43 @@ func (s *ComputableSlice[T]) Sum() T {
 	return sum
 }
 
-// Max returns the maximum element in ComputableSlice.
+// Max returns the maximum element in ComputableSlice. It panics if the slice is empty.
 func (s *ComputableSlice[T]) Max() T {
-	var cp = NewComputableSlice(s.slice.Clone().data...).Sort(true)
-	return cp.slice.data[0]
+	var result = s.slice.data[0]
+	for _, item := range s.slice.data[1:] {
+		if item > result {
+			result = item
+		}
+	}
+	return result
 }
 
-// Min returns the minimum element in ComputableSlice.
+// Min returns the minimum element in ComputableSlice. It panics if the slice is empty.
 func (s *ComputableSlice[T]) Min() T {
-	var cp = NewComputableSlice(s.slice.Clone().data...).Sort()
-	return cp.slice.data[0]
+	var result = s.slice.data[0]
+	for _, item := range s.slice.data[1:] {
+		if item < result {
+			result = item
+		}
+	}
+	return result
 }
 
-// Avg returns the average of all elements in the slice.
+// Avg returns the average of all elements in the slice. For integer types, the result is truncated
+// toward zero, use Mean to get a float64 result. The sum is accumulated without overflow or precision loss,
+// see SumFloat.
 func (s *ComputableSlice[T]) Avg() T {
 	if s.Len() == 0 {
 		return T(0)
 	}
-	return s.Sum() / T(s.Len())
+	if isFloat, _ := kindOf[T](); isFloat {
+		return T(s.neumaierSum() / float64(s.Len()))
+	}
+	avg := new(big.Int).Quo(s.wideSum(), big.NewInt(int64(s.Len())))
+	if avg.IsInt64() {
+		return T(avg.Int64())
+	}
+	return T(avg.Uint64())
 }
 
 // Slice returns a copy of the elements in ComputableSlice.
@@ -149,7 +167,23 @@ func (s *ComputableSlice[T]) Join(sep string) string {
 	return s.slice.Join(sep)
 }
 
+// IsSorted checks if the elements in ComputableSlice are in ascending order by default. If true is passed,
+// it checks for descending order.
+func (s *ComputableSlice[T]) IsSorted(desc ...bool) bool {
+	return isSortedOrdered(s.slice.data, len(desc) > 0 && desc[0])
+}
+
 // IsEmpty returns true if the underlying slice is empty.
 func (s *ComputableSlice[T]) IsEmpty() bool {
 	return s.slice.IsEmpty()
 }
+
+// Iterate calls f sequentially for each element in ComputableSlice. If f returns false, Iterate stops the iteration.
+func (s *ComputableSlice[T]) Iterate(f func(T) bool) {
+	s.slice.Iterate(f)
+}
+
+// AsSlice returns the underlying Slice of ComputableSlice. The two share the same underlying data.
+func (s *ComputableSlice[T]) AsSlice() *Slice[T] {
+	return s.slice
+}
@@ -0,0 +1,273 @@
+package sslice
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrEmptySlice is returned by the statistics methods of ComputableSlice when the slice is empty.
+var ErrEmptySlice = errors.New("slice is empty")
+
+// HistogramBin is a bin of the histogram returned by ComputableSlice.Histogram.
+// It counts the elements in the half-open interval [Lo, Hi), except the last bin, which is closed.
+type HistogramBin struct {
+	Lo, Hi float64
+	Count  int
+}
+
+// Mean returns the arithmetic mean of the elements in ComputableSlice as float64,
+// so it does not truncate for integer types like Avg does. The sum is accumulated by SumFloat.
+func (s *ComputableSlice[T]) Mean() (float64, error) {
+	if s.Len() == 0 {
+		return 0, ErrEmptySlice
+	}
+	return s.SumFloat() / float64(s.Len()), nil
+}
+
+// Median returns the median of the elements in ComputableSlice.
+// For an even number of elements, it returns the mean of the two middle elements.
+func (s *ComputableSlice[T]) Median() (float64, error) {
+	return s.Percentile(50)
+}
+
+// Percentile returns the p-th percentile (0 <= p <= 100) of the elements in ComputableSlice.
+// It interpolates linearly between the two closest ranks, which is the default method of numpy and Excel.
+// It uses a selection algorithm on a copy of the elements, which runs in O(n) on average.
+func (s *ComputableSlice[T]) Percentile(p float64) (float64, error) {
+	if s.Len() == 0 {
+		return 0, ErrEmptySlice
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, errors.New("percentile must be in the range [0, 100]")
+	}
+	return quantile(s.floats(), p/100), nil
+}
+
+// Quantiles divides the elements in ComputableSlice into n intervals with equal probability,
+// and returns the n-1 cut points. For example, Quantiles(4) returns the quartiles.
+func (s *ComputableSlice[T]) Quantiles(n int) ([]float64, error) {
+	if s.Len() == 0 {
+		return nil, ErrEmptySlice
+	}
+	if n < 2 {
+		return nil, errors.New("n must be at least 2")
+	}
+	var data = s.floats()
+	var cuts = make([]float64, 0, n-1)
+	var from int // elements before from are already known to be less than or equal to the rest
+	for i := 1; i < n; i++ {
+		h := float64(len(data)-1) * float64(i) / float64(n)
+		lo := int(h)
+		selectKth(data[from:], lo-from)
+		from = lo
+		cuts = append(cuts, interpolate(data, lo, h))
+	}
+	return cuts, nil
+}
+
+// Variance returns the population variance of the elements in ComputableSlice.
+// It uses the two-pass algorithm with compensated summation to keep the result numerically stable.
+// If true is passed, it returns the sample variance, which divides by n-1 instead of n.
+func (s *ComputableSlice[T]) Variance(sample ...bool) (float64, error) {
+	var n = s.Len()
+	if n == 0 {
+		return 0, ErrEmptySlice
+	}
+	var isSample = len(sample) > 0 && sample[0]
+	if isSample && n < 2 {
+		return 0, errors.New("sample variance requires at least 2 elements")
+	}
+	mean, _ := s.Mean()
+	var acc neumaier
+	for _, item := range s.slice.data {
+		d := float64(item) - mean
+		acc.add(d * d)
+	}
+	if isSample {
+		return acc.result() / float64(n-1), nil
+	}
+	return acc.result() / float64(n), nil
+}
+
+// StdDev returns the population standard deviation of the elements in ComputableSlice.
+// If true is passed, it returns the sample standard deviation.
+func (s *ComputableSlice[T]) StdDev(sample ...bool) (float64, error) {
+	variance, err := s.Variance(sample...)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Mode returns the most frequent element in ComputableSlice.
+// If several elements have the same frequency, the one that appears first wins.
+func (s *ComputableSlice[T]) Mode() (T, error) {
+	var mode T
+	if s.Len() == 0 {
+		return mode, ErrEmptySlice
+	}
+	var counts = make(map[T]int)
+	var maxCount int
+	for _, item := range s.slice.data {
+		counts[item]++
+		if c := counts[item]; c > maxCount {
+			mode, maxCount = item, c
+		}
+	}
+	// the first element that reaches maxCount is not necessarily the first one that appears
+	for _, item := range s.slice.data {
+		if counts[item] == maxCount {
+			return item, nil
+		}
+	}
+	return mode, nil
+}
+
+// Histogram divides the range between the minimum and maximum elements in ComputableSlice into
+// the given number of equal-width bins, and counts the elements in each bin.
+// It returns an error if an element is NaN or ±Inf, or if the range overflows float64, since the bins are
+// not defined then.
+func (s *ComputableSlice[T]) Histogram(bins int) ([]HistogramBin, error) {
+	if s.Len() == 0 {
+		return nil, ErrEmptySlice
+	}
+	if bins < 1 {
+		return nil, errors.New("bins must be at least 1")
+	}
+	for _, item := range s.slice.data {
+		if f := float64(item); math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, errors.New("histogram requires finite elements")
+		}
+	}
+	var lo, hi = float64(s.Min()), float64(s.Max())
+	if math.IsInf(hi-lo, 0) {
+		return nil, errors.New("histogram range overflows float64")
+	}
+	var width = (hi - lo) / float64(bins)
+	var result = make([]HistogramBin, bins)
+	for i := range result {
+		result[i].Lo = lo + float64(i)*width
+		result[i].Hi = lo + float64(i+1)*width
+	}
+	result[bins-1].Hi = hi
+	for _, item := range s.slice.data {
+		var i int
+		if width > 0 {
+			i = int((float64(item) - lo) / width)
+		}
+		if i >= bins {
+			i = bins - 1
+		}
+		result[i].Count++
+	}
+	return result, nil
+}
+
+// ZScores returns the standard score of each element in ComputableSlice, which is the number of
+// population standard deviations by which the element is above the mean.
+func (s *ComputableSlice[T]) ZScores() ([]float64, error) {
+	stdDev, err := s.StdDev()
+	if err != nil {
+		return nil, err
+	}
+	if stdDev == 0 {
+		return nil, errors.New("standard deviation is zero")
+	}
+	mean, _ := s.Mean()
+	var scores = make([]float64, 0, s.Len())
+	for _, item := range s.slice.data {
+		scores = append(scores, (float64(item)-mean)/stdDev)
+	}
+	return scores, nil
+}
+
+// CumSum returns a new ComputableSlice of the cumulative sums of the elements in ComputableSlice.
+func (s *ComputableSlice[T]) CumSum() *ComputableSlice[T] {
+	var data = make([]T, 0, s.Len())
+	var sum T
+	for _, item := range s.slice.data {
+		sum += item
+		data = append(data, sum)
+	}
+	return NewComputableSlice(data...)
+}
+
+// floats returns a float64 copy of the elements in ComputableSlice.
+func (s *ComputableSlice[T]) floats() []float64 {
+	var data = make([]float64, 0, s.Len())
+	for _, item := range s.slice.data {
+		data = append(data, float64(item))
+	}
+	return data
+}
+
+// quantile returns the q-th quantile (0 <= q <= 1) of data with linear interpolation.
+// It reorders data.
+func quantile(data []float64, q float64) float64 {
+	h := float64(len(data)-1) * q
+	lo := int(h)
+	selectKth(data, lo)
+	return interpolate(data, lo, h)
+}
+
+// interpolate interpolates linearly between data[lo] and the next order statistic at position h.
+// data must be partitioned around lo, i.e. data[lo] is the lo-th smallest element and every
+// element after it is greater than or equal to it.
+func interpolate(data []float64, lo int, h float64) float64 {
+	frac := h - float64(lo)
+	if frac == 0 || lo+1 >= len(data) {
+		return data[lo]
+	}
+	next := data[lo+1]
+	for _, v := range data[lo+2:] {
+		if v < next {
+			next = v
+		}
+	}
+	return data[lo] + frac*(next-data[lo])
+}
+
+// selectKth reorders data so that data[k] is the k-th smallest element (0-based),
+// elements before k are less than or equal to it, and elements after k are greater than or equal to it.
+// It uses quickselect with three-way partitioning, which runs in O(n) on average.
+func selectKth(data []float64, k int) {
+	lo, hi := 0, len(data)-1
+	for lo < hi {
+		// median of three as the pivot
+		mid := lo + (hi-lo)/2
+		if data[mid] < data[lo] {
+			data[mid], data[lo] = data[lo], data[mid]
+		}
+		if data[hi] < data[lo] {
+			data[hi], data[lo] = data[lo], data[hi]
+		}
+		if data[hi] < data[mid] {
+			data[hi], data[mid] = data[mid], data[hi]
+		}
+		pivot := data[mid]
+
+		// partition into [lo, lt) < pivot, [lt, gt] == pivot, (gt, hi] > pivot
+		lt, i, gt := lo, lo, hi
+		for i <= gt {
+			switch {
+			case data[i] < pivot:
+				data[lt], data[i] = data[i], data[lt]
+				lt++
+				i++
+			case data[i] > pivot:
+				data[i], data[gt] = data[gt], data[i]
+				gt--
+			default:
+				i++
+			}
+		}
+		switch {
+		case k < lt:
+			hi = lt - 1
+		case k > gt:
+			lo = gt + 1
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package sslice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestComputableSlice_Stats_EmptySlice_ReturnsError(t *testing.T) {
+	s := NewComputableSlice[int]()
+	_, err := s.Mean()
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = s.Median()
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = s.Percentile(90)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = s.Quantiles(4)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = s.Variance()
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = s.StdDev()
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = s.Mode()
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = s.Histogram(2)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = s.ZScores()
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	assert.True(t, s.CumSum().IsEmpty())
+}
+
+func TestComputableSlice_Mean_IntSlice_DoesNotTruncate(t *testing.T) {
+	mean, err := NewComputableSlice(1, 2).Mean()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, mean)
+}
+
+func TestComputableSlice_Median(t *testing.T) {
+	s := NewComputableSlice(5, 1, 4, 2, 3)
+	median, err := s.Median()
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, median)
+
+	median, _ = NewComputableSlice(4, 1, 3, 2).Median()
+	assert.Equal(t, 2.5, median)
+
+	// does make influence on the original slice
+	assert.Equal(t, []int{5, 1, 4, 2, 3}, s.Slice())
+}
+
+func TestComputableSlice_Percentile(t *testing.T) {
+	s := NewComputableSlice(15, 20, 35, 40, 50)
+	tests := []struct {
+		p, expected float64
+	}{
+		{0, 15}, {25, 20}, {40, 29}, {50, 35}, {100, 50},
+	}
+	for _, test := range tests {
+		result, err := s.Percentile(test.p)
+		assert.NoError(t, err)
+		assert.InDelta(t, test.expected, result, 1e-9, "Percentile(%v)", test.p)
+	}
+	_, err := s.Percentile(101)
+	assert.Error(t, err)
+	_, err = s.Percentile(-1)
+	assert.Error(t, err)
+}
+
+func TestComputableSlice_Percentile_MatchesSortedReference(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	data := make([]float64, 1001)
+	for i := range data {
+		data[i] = float64(r.Intn(100)) // many duplicates
+	}
+	s := NewComputableSlice(data...)
+	sorted := s.Clone().Sort().Slice()
+	for _, p := range []float64{1, 10, 33.3, 50, 90, 99.9} {
+		h := float64(len(sorted)-1) * p / 100
+		lo := int(h)
+		expected := sorted[lo] + (h-float64(lo))*(sorted[lo+1]-sorted[lo])
+		result, _ := s.Percentile(p)
+		assert.InDelta(t, expected, result, 1e-9, "Percentile(%v)", p)
+	}
+}
+
+func TestComputableSlice_Quantiles(t *testing.T) {
+	s := NewComputableSlice(9, 1, 8, 2, 7, 3, 6, 4, 5)
+	quartiles, err := s.Quantiles(4)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{3, 5, 7}, quartiles)
+
+	deciles, _ := s.Quantiles(10)
+	assert.True(t, sort.Float64sAreSorted(deciles))
+	assert.Len(t, deciles, 9)
+
+	_, err = s.Quantiles(1)
+	assert.Error(t, err)
+}
+
+func TestComputableSlice_VarianceAndStdDev(t *testing.T) {
+	s := NewComputableSlice(2, 4, 4, 4, 5, 5, 7, 9)
+	variance, err := s.Variance()
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, variance)
+	stdDev, _ := s.StdDev()
+	assert.Equal(t, 2.0, stdDev)
+
+	sampleVariance, _ := s.Variance(true)
+	assert.InDelta(t, 32.0/7, sampleVariance, 1e-12)
+	sampleStdDev, _ := s.StdDev(true)
+	assert.InDelta(t, math.Sqrt(32.0/7), sampleStdDev, 1e-12)
+
+	_, err = NewComputableSlice(1).Variance(true)
+	assert.Error(t, err)
+}
+
+func TestComputableSlice_Mode_TieReturnsFirstAppearing(t *testing.T) {
+	mode, err := NewComputableSlice(3, 1, 1, 3, 2).Mode()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, mode)
+
+	floatMode, _ := NewComputableSlice(1.5, 2.5, 2.5).Mode()
+	assert.Equal(t, 2.5, floatMode)
+}
+
+func TestComputableSlice_Histogram(t *testing.T) {
+	bins, err := NewComputableSlice(0, 1, 2, 3, 4, 5, 6, 7, 8, 10).Histogram(5)
+	assert.NoError(t, err)
+	assert.Equal(t, []HistogramBin{
+		{0, 2, 2}, {2, 4, 2}, {4, 6, 2}, {6, 8, 2}, {8, 10, 2},
+	}, bins)
+
+	bins, _ = NewComputableSlice(3, 3, 3).Histogram(2)
+	assert.Equal(t, []HistogramBin{{3, 3, 3}, {3, 3, 0}}, bins)
+
+	_, err = NewComputableSlice(1).Histogram(0)
+	assert.Error(t, err)
+
+	_, err = NewComputableSlice(1, math.NaN(), 3).Histogram(2)
+	assert.Error(t, err)
+	_, err = NewComputableSlice(1, math.Inf(1), 3).Histogram(2)
+	assert.Error(t, err)
+	_, err = NewComputableSlice(-math.MaxFloat64, math.MaxFloat64).Histogram(2)
+	assert.Error(t, err)
+}
+
+func TestComputableSlice_ZScores(t *testing.T) {
+	scores, err := NewComputableSlice(2, 4, 4, 4, 5, 5, 7, 9).ZScores()
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}, scores)
+
+	_, err = NewComputableSlice(1, 1).ZScores()
+	assert.Error(t, err)
+}
+
+func TestComputableSlice_CumSum(t *testing.T) {
+	s := NewComputableSlice(1, 2, 3, 4)
+	assert.Equal(t, []int{1, 3, 6, 10}, s.CumSum().Slice())
+	assert.Equal(t, []int{1, 2, 3, 4}, s.Slice())
+}
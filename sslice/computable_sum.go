@@ -0,0 +1,135 @@
+package sslice
+
+import (
+	"errors"
+	"github.com/chaseSpace/bear/constraints"
+	"math"
+	"math/big"
+)
+
+// ErrOverflow is returned by ComputableSlice.SumChecked when the sum does not fit in the element type.
+var ErrOverflow = errors.New("sum overflows the element type")
+
+// SumChecked returns the sum of all elements in ComputableSlice. Unlike Sum, it returns ErrOverflow
+// instead of silently wrapping around when an integer sum overflows, or when a float sum becomes infinite.
+// Float elements are added with compensated summation, see SumFloat.
+func (s *ComputableSlice[T]) SumChecked() (T, error) {
+	isFloat, isSigned := kindOf[T]()
+	if isFloat {
+		var sum = T(s.neumaierSum())
+		if math.IsInf(float64(sum), 0) && !s.hasInf() {
+			return sum, ErrOverflow
+		}
+		return sum, nil
+	}
+
+	var sum T
+	for _, item := range s.slice.data {
+		next := sum + item
+		if isSigned && (item > 0 && next < sum || item < 0 && next > sum) ||
+			!isSigned && next < sum {
+			return next, ErrOverflow
+		}
+		sum = next
+	}
+	return sum, nil
+}
+
+// SumFloat returns the sum of all elements in ComputableSlice as float64.
+// Integer elements are accumulated exactly in a wide integer (see SumWide) and rounded once at the end.
+// Float elements are accumulated in float64 with Kahan-Babuska-Neumaier compensated summation,
+// so the rounding error does not grow with the number of elements.
+func (s *ComputableSlice[T]) SumFloat() float64 {
+	if isFloat, _ := kindOf[T](); isFloat {
+		return s.neumaierSum()
+	}
+	f, _ := new(big.Float).SetInt(s.wideSum()).Float64()
+	return f
+}
+
+// SumWide returns the exact sum of all elements in an integer ComputableSlice. It accumulates in
+// int64 or uint64, and only switches to big.Int arithmetic when the 64-bit accumulator would overflow.
+func SumWide[T constraints.Integer](s *ComputableSlice[T]) *big.Int {
+	return s.wideSum()
+}
+
+// wideSum returns the exact sum of the elements, which must be of an integer type.
+func (s *ComputableSlice[T]) wideSum() *big.Int {
+	var total = new(big.Int)
+	if _, isSigned := kindOf[T](); isSigned {
+		var acc int64
+		for _, item := range s.slice.data {
+			v := int64(item)
+			next := acc + v
+			if v > 0 && next < acc || v < 0 && next > acc {
+				total.Add(total, big.NewInt(acc))
+				next = v
+			}
+			acc = next
+		}
+		return total.Add(total, big.NewInt(acc))
+	}
+
+	var acc uint64
+	for _, item := range s.slice.data {
+		v := uint64(item)
+		next := acc + v
+		if next < acc {
+			total.Add(total, new(big.Int).SetUint64(acc))
+			next = v
+		}
+		acc = next
+	}
+	return total.Add(total, new(big.Int).SetUint64(acc))
+}
+
+// neumaierSum returns the sum of the elements in float64 with compensated summation.
+func (s *ComputableSlice[T]) neumaierSum() float64 {
+	var acc neumaier
+	for _, item := range s.slice.data {
+		acc.add(float64(item))
+	}
+	return acc.result()
+}
+
+// neumaier is a float64 accumulator using Neumaier's improved Kahan summation.
+// It keeps the low-order bits lost by each addition in a separate compensation term.
+type neumaier struct {
+	sum, compensation float64
+}
+
+func (n *neumaier) add(v float64) {
+	t := n.sum + v
+	if math.Abs(n.sum) >= math.Abs(v) {
+		n.compensation += (n.sum - t) + v
+	} else {
+		n.compensation += (v - t) + n.sum
+	}
+	n.sum = t
+}
+
+func (n *neumaier) result() float64 {
+	if math.IsInf(n.sum, 0) || math.IsNaN(n.sum) { // the compensation is NaN then
+		return n.sum
+	}
+	return n.sum + n.compensation
+}
+
+// hasInf returns true if any element is infinite.
+func (s *ComputableSlice[T]) hasInf() bool {
+	for _, item := range s.slice.data {
+		if math.IsInf(float64(item), 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// kindOf reports whether T is a float type, and whether it is a signed type.
+func kindOf[T constraints.Computable]() (isFloat, isSigned bool) {
+	var half T = 1
+	half /= 2
+	var negative T
+	negative--
+	return half != 0, negative < 0
+}
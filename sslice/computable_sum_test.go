@@ -0,0 +1,92 @@
+package sslice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestComputableSlice_SumChecked_Int8Overflow_ReturnsError(t *testing.T) {
+	sum, err := NewComputableSlice[int8](100, 20).SumChecked()
+	assert.NoError(t, err)
+	assert.Equal(t, int8(120), sum)
+
+	_, err = NewComputableSlice[int8](100, 20, 10).SumChecked()
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = NewComputableSlice[int8](-100, -20, -10).SumChecked()
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	// the intermediate sum stays in range
+	sum, err = NewComputableSlice[int8](100, -100, 100).SumChecked()
+	assert.NoError(t, err)
+	assert.Equal(t, int8(100), sum)
+}
+
+func TestComputableSlice_SumChecked_UnsignedOverflow_ReturnsError(t *testing.T) {
+	_, err := NewComputableSlice[uint16](math.MaxUint16, 1).SumChecked()
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	sum, err := NewComputableSlice[uint16](math.MaxUint16-1, 1).SumChecked()
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(math.MaxUint16), sum)
+}
+
+func TestComputableSlice_SumChecked_FloatOverflow_ReturnsError(t *testing.T) {
+	_, err := NewComputableSlice[float32](math.MaxFloat32, math.MaxFloat32).SumChecked()
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	sum, err := NewComputableSlice(math.Inf(1), 1).SumChecked()
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(sum, 1))
+}
+
+func TestSumWide_Int64Overflow_ReturnsExactSum(t *testing.T) {
+	s := NewComputableSlice[int64](math.MaxInt64, math.MaxInt64, 2)
+	expected := new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(2))
+	expected.Add(expected, big.NewInt(2))
+	assert.Equal(t, 0, expected.Cmp(SumWide(s)))
+
+	u := NewComputableSlice[uint64](math.MaxUint64, 1)
+	expected = new(big.Int).SetUint64(math.MaxUint64)
+	expected.Add(expected, big.NewInt(1))
+	assert.Equal(t, 0, expected.Cmp(SumWide(u)))
+
+	assert.Equal(t, int64(-6), SumWide(NewComputableSlice[int8](-100, 127, -33)).Int64())
+}
+
+func TestComputableSlice_SumFloat_CompensatesRoundingError(t *testing.T) {
+	var data []float64
+	for i := 0; i < 10000; i++ {
+		data = append(data, 0.1)
+	}
+	s := NewComputableSlice(data...)
+	assert.Equal(t, 1000.0, s.SumFloat())
+	assert.NotEqual(t, 1000.0, s.Sum()) // the naive sum drifts
+
+	// the small values are lost entirely without compensation
+	assert.Equal(t, 2.0, NewComputableSlice(1.0, 1e100, 1.0, -1e100).SumFloat())
+
+	var f32 []float32
+	for i := 0; i < 1000000; i++ {
+		f32 = append(f32, 0.1)
+	}
+	assert.InDelta(t, 100000.0, NewComputableSlice(f32...).SumFloat(), 1.0)
+}
+
+func TestComputableSlice_Avg_NoOverflow(t *testing.T) {
+	assert.Equal(t, int8(110), NewComputableSlice[int8](100, 120).Avg())
+	assert.Equal(t, uint64(math.MaxUint64-1), NewComputableSlice[uint64](math.MaxUint64, math.MaxUint64-2).Avg())
+
+	mean, err := NewComputableSlice[int8](127, 127, 127).Mean()
+	assert.NoError(t, err)
+	assert.Equal(t, 127.0, mean)
+}
+
+func TestComputableSlice_Variance_LargeOffset_StaysAccurate(t *testing.T) {
+	s := NewComputableSlice(1e9+4, 1e9+7, 1e9+13, 1e9+16)
+	variance, err := s.Variance(true)
+	assert.NoError(t, err)
+	assert.InDelta(t, 30.0, variance, 1e-9)
+}
@@ -0,0 +1,243 @@
+package sslice
+
+import "sync"
+
+// ConcurrentSlice is a Slice that is safe for concurrent use by multiple goroutines.
+// Reads share a sync.RWMutex, while writes hold it exclusively.
+//
+// The callbacks of Reduce and Iterate run on a snapshot without holding the lock, so they can
+// call other methods of the slice. The callbacks of Filter, Map and Compute run under the write lock,
+// so they must not call methods of the same slice.
+type ConcurrentSlice[T comparable] struct {
+	mu    sync.RWMutex
+	slice *Slice[T]
+}
+
+// NewConcurrent creates a new ptr of ConcurrentSlice type.
+func NewConcurrent[T comparable](items ...T) *ConcurrentSlice[T] {
+	return &ConcurrentSlice[T]{slice: New(items...)}
+}
+
+// Append appends data to ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Append(items ...T) *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.Append(items...)
+	return s
+}
+
+// Clone returns a copy of ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Clone() *ConcurrentSlice[T] {
+	return &ConcurrentSlice[T]{slice: s.Snapshot()}
+}
+
+// Filter removes elements that match f function from ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Filter(f func(T) bool) *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.Filter(f)
+	return s
+}
+
+// Map maps the elements in ConcurrentSlice by the given function.
+func (s *ConcurrentSlice[T]) Map(f func(T) T) *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.Map(f)
+	return s
+}
+
+// Unique removes duplicate elements in ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Unique() *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.Unique()
+	return s
+}
+
+// Reverse reverses the elements in ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Reverse() *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.Reverse()
+	return s
+}
+
+// Shuffle shuffles the elements in ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Shuffle() *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.Shuffle()
+	return s
+}
+
+// PopLeft pops the leftmost element in ConcurrentSlice.
+func (s *ConcurrentSlice[T]) PopLeft() *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.PopLeft()
+	return s
+}
+
+// PopRight pops the rightmost element in ConcurrentSlice.
+func (s *ConcurrentSlice[T]) PopRight() *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.PopRight()
+	return s
+}
+
+// SortFunc sorts the elements in ConcurrentSlice by less. The sort is not stable.
+func (s *ConcurrentSlice[T]) SortFunc(less func(a, b T) bool) *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.SortFunc(less)
+	return s
+}
+
+// SortStableFunc sorts the elements in ConcurrentSlice by less, keeping the original order of equal elements.
+func (s *ConcurrentSlice[T]) SortStableFunc(less func(a, b T) bool) *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.SortStableFunc(less)
+	return s
+}
+
+// SortBy sorts the elements in ConcurrentSlice by keys, like Slice.SortBy.
+func (s *ConcurrentSlice[T]) SortBy(keys ...SortKey[T]) *ConcurrentSlice[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.slice.SortBy(keys...)
+	return s
+}
+
+// ------------------ split line ------------------------
+// - Below are non-chain methods.
+
+// AppendIfAbsent appends item to ConcurrentSlice atomically if it is not present.
+// It returns true if item has been appended.
+func (s *ConcurrentSlice[T]) AppendIfAbsent(item T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.slice.Contains(item) {
+		return false
+	}
+	s.slice.Append(item)
+	return true
+}
+
+// PopLeftValue pops the leftmost element in ConcurrentSlice atomically, and returns it.
+// The bool is false if the slice is empty.
+func (s *ConcurrentSlice[T]) PopLeftValue() (item T, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.slice.IsEmpty() {
+		return
+	}
+	item = s.slice.data[0]
+	s.slice.PopLeft()
+	return item, true
+}
+
+// PopRightValue pops the rightmost element in ConcurrentSlice atomically, and returns it.
+// The bool is false if the slice is empty.
+func (s *ConcurrentSlice[T]) PopRightValue() (item T, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.slice.IsEmpty() {
+		return
+	}
+	item = s.slice.data[len(s.slice.data)-1]
+	s.slice.PopRight()
+	return item, true
+}
+
+// Compute calls f with the underlying Slice under the write lock, so that a compound operation runs atomically.
+// The Slice must not be retained after f returns.
+func (s *ConcurrentSlice[T]) Compute(f func(slice *Slice[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s.slice)
+}
+
+// Snapshot returns a copy of ConcurrentSlice as a plain Slice.
+func (s *ConcurrentSlice[T]) Snapshot() *Slice[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.Clone()
+}
+
+// Slice returns a copy of the elements in ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Slice() (copied []T) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.Slice()
+}
+
+// Len returns the length of ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.Len()
+}
+
+// Contains returns true if the element is in ConcurrentSlice.
+func (s *ConcurrentSlice[T]) Contains(item T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.Contains(item)
+}
+
+// Reduce reduces a snapshot of ConcurrentSlice by the given function, without holding the lock.
+func (s *ConcurrentSlice[T]) Reduce(f func(x, y T) T) T {
+	return s.Snapshot().Reduce(f)
+}
+
+// Equal returns true if the elements in ConcurrentSlice are equal to the elements in other.
+func (s *ConcurrentSlice[T]) Equal(other *ConcurrentSlice[T]) bool {
+	var snapshot = other.Snapshot()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.Equal(snapshot)
+}
+
+// IndexOf returns the index of the element in ConcurrentSlice. If the element is not found, it returns -1.
+func (s *ConcurrentSlice[T]) IndexOf(item T) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.IndexOf(item)
+}
+
+// Get returns the element at the given index.
+func (s *ConcurrentSlice[T]) Get(index int) T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.Get(index)
+}
+
+// Join joins the elements in ConcurrentSlice by the given separator.
+func (s *ConcurrentSlice[T]) Join(sep string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.Join(sep)
+}
+
+// IsSorted checks if the elements in ConcurrentSlice are sorted by less.
+func (s *ConcurrentSlice[T]) IsSorted(less func(a, b T) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.IsSorted(less)
+}
+
+// IsEmpty returns true if ConcurrentSlice is empty.
+func (s *ConcurrentSlice[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.slice.IsEmpty()
+}
+
+// Iterate calls f sequentially for each element in a snapshot of ConcurrentSlice, without holding the lock.
+// If f returns false, Iterate stops the iteration.
+func (s *ConcurrentSlice[T]) Iterate(f func(T) bool) {
+	s.Snapshot().Iterate(f)
+}
@@ -0,0 +1,102 @@
+package sslice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSlice_ConcurrentAppend_AllItemsAppended(t *testing.T) {
+	s := NewConcurrent[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				s.Append(i)
+				s.Len()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 8000, s.Len())
+}
+
+func TestConcurrentSlice_PopLeftValue_EachItemPoppedOnce(t *testing.T) {
+	s := NewConcurrent[int]()
+	for i := 0; i < 1000; i++ {
+		s.Append(i)
+	}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var popped = make(map[int]int)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v, ok := s.PopLeftValue()
+				if !ok {
+					return
+				}
+				mu.Lock()
+				popped[v]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, popped, 1000)
+	for v, n := range popped {
+		assert.Equal(t, 1, n, "item %d popped %d times", v, n)
+	}
+}
+
+func TestConcurrentSlice_PopRightValue(t *testing.T) {
+	s := NewConcurrent(1, 2)
+	v, ok := s.PopRightValue()
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	s.PopRightValue()
+	_, ok = s.PopRightValue()
+	assert.False(t, ok)
+}
+
+func TestConcurrentSlice_AppendIfAbsent(t *testing.T) {
+	s := NewConcurrent[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				s.AppendIfAbsent(i)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 100, s.Len())
+}
+
+func TestConcurrentSlice_Compute_And_Snapshot(t *testing.T) {
+	s := NewConcurrent(3, 1, 2)
+	s.Compute(func(slice *Slice[int]) {
+		AsOrdered(slice).Sort()
+	})
+	assert.Equal(t, []int{1, 2, 3}, s.Slice())
+
+	snapshot := s.Snapshot()
+	s.Append(4)
+	assert.Equal(t, 3, snapshot.Len())
+
+	var visited []int
+	s.Iterate(func(i int) bool {
+		s.Append(i) // does not deadlock because the callback runs on a snapshot
+		visited = append(visited, i)
+		return true
+	})
+	assert.Equal(t, []int{1, 2, 3, 4}, visited)
+	assert.Equal(t, 8, s.Len())
+	assert.Equal(t, 20, s.Reduce(func(x, y int) int { return x + y }))
+}
@@ -0,0 +1,125 @@
+//go:build go1.23
+
+package sslice
+
+import (
+	"github.com/chaseSpace/bear/constraints"
+	"iter"
+)
+
+// FromSeq creates a new Slice from the values of seq.
+func FromSeq[T comparable](seq iter.Seq[T]) *Slice[T] {
+	var s = New[T]()
+	for item := range seq {
+		s.data = append(s.data, item)
+	}
+	return s
+}
+
+// OrderedSliceFromSeq creates a new OrderedSlice from the values of seq.
+func OrderedSliceFromSeq[T constraints.Ordered](seq iter.Seq[T]) *OrderedSlice[T] {
+	return &OrderedSlice[T]{slice: FromSeq(seq)}
+}
+
+// ComputableSliceFromSeq creates a new ComputableSlice from the values of seq.
+func ComputableSliceFromSeq[T constraints.Computable](seq iter.Seq[T]) *ComputableSlice[T] {
+	return &ComputableSlice[T]{slice: FromSeq(seq)}
+}
+
+// All returns an iterator over the index-value pairs in Slice, in order.
+func (s *Slice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, item := range s.data {
+			if !yield(i, item) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values in Slice, in order.
+func (s *Slice[T]) Values() iter.Seq[T] {
+	return s.Iterate
+}
+
+// Backward returns an iterator over the index-value pairs in Slice, traversing it backward.
+func (s *Slice[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(s.data) - 1; i >= 0; i-- {
+			if !yield(i, s.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the index-value pairs in OrderedSlice, in order.
+func (s *OrderedSlice[T]) All() iter.Seq2[int, T] {
+	return s.slice.All()
+}
+
+// Values returns an iterator over the values in OrderedSlice, in order.
+func (s *OrderedSlice[T]) Values() iter.Seq[T] {
+	return s.slice.Values()
+}
+
+// Backward returns an iterator over the index-value pairs in OrderedSlice, traversing it backward.
+func (s *OrderedSlice[T]) Backward() iter.Seq2[int, T] {
+	return s.slice.Backward()
+}
+
+// All returns an iterator over the index-value pairs in ComputableSlice, in order.
+func (s *ComputableSlice[T]) All() iter.Seq2[int, T] {
+	return s.slice.All()
+}
+
+// Values returns an iterator over the values in ComputableSlice, in order.
+func (s *ComputableSlice[T]) Values() iter.Seq[T] {
+	return s.slice.Values()
+}
+
+// Backward returns an iterator over the index-value pairs in ComputableSlice, traversing it backward.
+func (s *ComputableSlice[T]) Backward() iter.Seq2[int, T] {
+	return s.slice.Backward()
+}
+
+// SortedSliceFromSeq creates a new SortedSlice from the values of seq.
+func SortedSliceFromSeq[T constraints.Ordered](seq iter.Seq[T]) *SortedSlice[T] {
+	return NewSortedSlice(FromSeq(seq).data...)
+}
+
+// All returns an iterator over the index-value pairs in SortedSlice, in ascending order.
+func (s *SortedSlice[T]) All() iter.Seq2[int, T] {
+	return s.slice.All()
+}
+
+// Values returns an iterator over the values in SortedSlice, in ascending order.
+func (s *SortedSlice[T]) Values() iter.Seq[T] {
+	return s.slice.Values()
+}
+
+// Backward returns an iterator over the index-value pairs in SortedSlice, in descending order.
+func (s *SortedSlice[T]) Backward() iter.Seq2[int, T] {
+	return s.slice.Backward()
+}
+
+// All returns an iterator over the index-value pairs in a snapshot of ConcurrentSlice,
+// taken when the iteration starts.
+func (s *ConcurrentSlice[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		s.Snapshot().All()(yield)
+	}
+}
+
+// Values returns an iterator over the values in a snapshot of ConcurrentSlice, taken when the iteration starts.
+func (s *ConcurrentSlice[T]) Values() iter.Seq[T] {
+	return s.Iterate
+}
+
+// Backward returns an iterator over the index-value pairs in a snapshot of ConcurrentSlice,
+// traversing it backward.
+func (s *ConcurrentSlice[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		s.Snapshot().Backward()(yield)
+	}
+}
@@ -0,0 +1,60 @@
+//go:build go1.23
+
+package sslice
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestAll_RangeOverFunc_YieldsIndexAndValue tests ranging over All.
+func TestAll_RangeOverFunc_YieldsIndexAndValue(t *testing.T) {
+	s := New("a", "b", "c")
+	var indexes []int
+	var values []string
+	for i, v := range s.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	if !reflect.DeepEqual(indexes, []int{0, 1, 2}) || !reflect.DeepEqual(values, []string{"a", "b", "c"}) {
+		t.Errorf("Expected [0 1 2] [a b c], got %v %v", indexes, values)
+	}
+}
+
+// TestBackward_Break_StopsIteration tests ranging over Backward with break.
+func TestBackward_Break_StopsIteration(t *testing.T) {
+	s := NewOrderedSlice(1, 2, 3, 4)
+	var values []int
+	for i, v := range s.Backward() {
+		if i < 2 {
+			break
+		}
+		values = append(values, v)
+	}
+	expected := []int{4, 3}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+}
+
+// TestFromSeq_Values_RoundTrip tests building a Slice from the Values of another one.
+func TestFromSeq_Values_RoundTrip(t *testing.T) {
+	s := NewComputableSlice(1, 2, 3)
+	cp := ComputableSliceFromSeq(s.Values())
+	if !cp.Equal(s) || cp.Sum() != 6 {
+		t.Errorf("Expected %v, got %v", s.Slice(), cp.Slice())
+	}
+	if !FromSeq(New[int]().Values()).Equal(New[int]()) {
+		t.Errorf("Expected empty slice")
+	}
+}
+
+func TestCombinations_RangeOverFunc(t *testing.T) {
+	var pairs []string
+	for c := range Combinations(New("a", "b", "c"), 2) {
+		pairs = append(pairs, c.Join(""))
+	}
+	if !reflect.DeepEqual(pairs, []string{"ab", "ac", "bc"}) {
+		t.Errorf("Expected [ab ac bc], got %v", pairs)
+	}
+}
@@ -0,0 +1,117 @@
+package sslice
+
+import "fmt"
+
+// ListAdapter adapts Slice to the index-based method set of the linked lists, so that a Slice can be used
+// as a bear.List. Changes made through the adapter are made to the underlying Slice.
+type ListAdapter[T comparable] struct {
+	slice *Slice[T]
+}
+
+// AsList returns a ListAdapter over Slice.
+func (s *Slice[T]) AsList() *ListAdapter[T] {
+	return &ListAdapter[T]{slice: s}
+}
+
+// Slice returns the underlying Slice.
+func (l *ListAdapter[T]) Slice() *Slice[T] {
+	return l.slice
+}
+
+// Append adds one or more values to the end of the list.
+func (l *ListAdapter[T]) Append(val ...T) {
+	l.slice.Append(val...)
+}
+
+// InsertBefore inserts val before the element at the specified index.
+func (l *ListAdapter[T]) InsertBefore(index int, val T) error {
+	if err := l.checkIndex(index); err != nil {
+		return err
+	}
+	l.insert(index, val)
+	return nil
+}
+
+// InsertAfter inserts val after the element at the specified index.
+func (l *ListAdapter[T]) InsertAfter(index int, val T) error {
+	if err := l.checkIndex(index); err != nil {
+		return err
+	}
+	l.insert(index+1, val)
+	return nil
+}
+
+// Remove removes the element at the specified index. It does nothing if the index is out of range.
+func (l *ListAdapter[T]) Remove(index int) {
+	if index < 0 || index >= len(l.slice.data) {
+		return
+	}
+	l.slice.data = append(l.slice.data[:index], l.slice.data[index+1:]...)
+}
+
+// IndexOf returns the index of the first occurrence of val, or -1 if it is not found.
+func (l *ListAdapter[T]) IndexOf(val T) int {
+	return l.slice.IndexOf(val)
+}
+
+// Update updates the value of the element at the specified index.
+func (l *ListAdapter[T]) Update(index int, newVal T) error {
+	if index < 0 || index >= len(l.slice.data) {
+		return fmt.Errorf("index out of range")
+	}
+	l.slice.data[index] = newVal
+	return nil
+}
+
+// Iterate calls f sequentially for each element in order. If f returns false, Iterate stops the iteration.
+func (l *ListAdapter[T]) Iterate(f func(T) bool) {
+	l.slice.Iterate(f)
+}
+
+// Reverse reverses the elements.
+func (l *ListAdapter[T]) Reverse() {
+	l.slice.Reverse()
+}
+
+// ToSlice returns a copy of the elements.
+func (l *ListAdapter[T]) ToSlice() []T {
+	return l.slice.Slice()
+}
+
+// Length returns the number of elements.
+func (l *ListAdapter[T]) Length() int {
+	return l.slice.Len()
+}
+
+// IsEmpty checks if the list is empty.
+func (l *ListAdapter[T]) IsEmpty() bool {
+	return l.slice.IsEmpty()
+}
+
+// CountOf counts occurrences of val.
+func (l *ListAdapter[T]) CountOf(val T) int {
+	var count int
+	for _, item := range l.slice.data {
+		if item == val {
+			count++
+		}
+	}
+	return count
+}
+
+func (l *ListAdapter[T]) checkIndex(index int) error {
+	if index < 0 {
+		return fmt.Errorf("index must be zero or a positive number")
+	}
+	if index >= len(l.slice.data) {
+		return fmt.Errorf("index out of range")
+	}
+	return nil
+}
+
+func (l *ListAdapter[T]) insert(index int, val T) {
+	var zero T
+	l.slice.data = append(l.slice.data, zero)
+	copy(l.slice.data[index+1:], l.slice.data[index:])
+	l.slice.data[index] = val
+}
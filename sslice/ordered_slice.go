@@ -1,9 +1,6 @@
 package sslice
 
-import (
-	"github.com/chaseSpace/bear/constraints"
-	"sort"
-)
+import "github.com/chaseSpace/bear/constraints"
 
 type OrderedSlice[T constraints.Ordered] struct {
 	slice *Slice[T]
@@ -14,18 +11,16 @@ func NewOrderedSlice[T constraints.Ordered](items ...T) *OrderedSlice[T] {
 }
 
 // Sort sorts the elements in OrderedSlice in ascending order by default. If true is passed,
-// it sorts in descending order.
+// it sorts in descending order. The sort is not stable; use SortStable to keep the order of equal elements,
+// like +0 and -0.
 func (s *OrderedSlice[T]) Sort(desc ...bool) *OrderedSlice[T] {
-	if len(desc) > 0 && desc[0] { // desc sort
-		sort.Slice(s.slice.data, func(i, j int) bool {
-			return s.slice.data[i] > s.slice.data[j]
-		})
-		return s
-	}
-	// asc sort
-	sort.Slice(s.slice.data, func(i, j int) bool {
-		return s.slice.data[i] < s.slice.data[j]
-	})
+	sortOrdered(s.slice.data, len(desc) > 0 && desc[0], false)
+	return s
+}
+
+// SortStable sorts the elements in OrderedSlice like Sort, keeping the original order of equal elements.
+func (s *OrderedSlice[T]) SortStable(desc ...bool) *OrderedSlice[T] {
+	sortOrdered(s.slice.data, len(desc) > 0 && desc[0], true)
 	return s
 }
 
@@ -120,7 +115,23 @@ func (s *OrderedSlice[T]) Join(sep string) string {
 	return s.slice.Join(sep)
 }
 
+// IsSorted checks if the elements in OrderedSlice are in ascending order by default. If true is passed,
+// it checks for descending order.
+func (s *OrderedSlice[T]) IsSorted(desc ...bool) bool {
+	return isSortedOrdered(s.slice.data, len(desc) > 0 && desc[0])
+}
+
 // IsEmpty returns true if the underlying slice is empty.
 func (s *OrderedSlice[T]) IsEmpty() bool {
 	return s.slice.IsEmpty()
 }
+
+// Iterate calls f sequentially for each element in OrderedSlice. If f returns false, Iterate stops the iteration.
+func (s *OrderedSlice[T]) Iterate(f func(T) bool) {
+	s.slice.Iterate(f)
+}
+
+// AsSlice returns the underlying Slice of OrderedSlice. The two share the same underlying data.
+func (s *OrderedSlice[T]) AsSlice() *Slice[T] {
+	return s.slice
+}
@@ -7,8 +7,7 @@ import (
 )
 
 type Slice[T comparable] struct {
-	data   []T
-	sortFn func([]T, ...bool)
+	data []T
 }
 
 // New creates a new ptr of Slice type.
@@ -179,3 +178,12 @@ func (s *Slice[T]) Join(sep string) string {
 func (s *Slice[T]) IsEmpty() bool {
 	return len(s.data) == 0
 }
+
+// Iterate calls f sequentially for each element in Slice. If f returns false, Iterate stops the iteration.
+func (s *Slice[T]) Iterate(f func(T) bool) {
+	for _, item := range s.data {
+		if !f(item) {
+			return
+		}
+	}
+}
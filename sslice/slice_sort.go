@@ -0,0 +1,100 @@
+package sslice
+
+import (
+	"github.com/chaseSpace/bear/constraints"
+	"sort"
+)
+
+// SortKey compares two elements by one key, returning a negative number, zero or a positive number
+// if a sorts before, together with, or after b. Create one with By.
+type SortKey[T any] func(a, b T) int
+
+// By returns a SortKey that sorts the elements by the key returned by key, in ascending order.
+// Go methods cannot have their own type parameters, so the key type is bound here rather than in SortBy.
+func By[T any, K constraints.Ordered](key func(T) K) SortKey[T] {
+	return func(a, b T) int {
+		ka, kb := key(a), key(b)
+		switch {
+		case ka < kb:
+			return -1
+		case ka > kb:
+			return 1
+		}
+		return 0
+	}
+}
+
+// Desc returns a SortKey that sorts in the reverse order of k.
+func (k SortKey[T]) Desc() SortKey[T] {
+	return func(a, b T) int {
+		return k(b, a)
+	}
+}
+
+// SortFunc sorts the elements in Slice by less. The sort is not stable. It returns the Slice itself.
+func (s *Slice[T]) SortFunc(less func(a, b T) bool) *Slice[T] {
+	sort.Slice(s.data, func(i, j int) bool {
+		return less(s.data[i], s.data[j])
+	})
+	return s
+}
+
+// SortStableFunc sorts the elements in Slice by less, keeping the original order of equal elements.
+// It returns the Slice itself.
+func (s *Slice[T]) SortStableFunc(less func(a, b T) bool) *Slice[T] {
+	sort.SliceStable(s.data, func(i, j int) bool {
+		return less(s.data[i], s.data[j])
+	})
+	return s
+}
+
+// SortBy sorts the elements in Slice by keys, e.g. s.SortBy(By(func(u User) string { return u.Name })).
+// The elements that are equal by a key are ordered by the next one, and the elements that are equal by all
+// keys keep their original order. It returns the Slice itself.
+func (s *Slice[T]) SortBy(keys ...SortKey[T]) *Slice[T] {
+	return s.SortStableFunc(func(a, b T) bool {
+		return compareByKeys(keys, a, b) < 0
+	})
+}
+
+// IsSorted checks if the elements in Slice are sorted by less.
+func (s *Slice[T]) IsSorted(less func(a, b T) bool) bool {
+	for i := len(s.data) - 1; i > 0; i-- {
+		if less(s.data[i], s.data[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func compareByKeys[T any](keys []SortKey[T], a, b T) int {
+	for _, key := range keys {
+		if c := key(a, b); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// sortOrdered sorts data in ascending order, or in descending order if desc is true.
+func sortOrdered[T constraints.Ordered](data []T, desc, stable bool) {
+	var less = func(i, j int) bool { return data[i] < data[j] }
+	if desc {
+		less = func(i, j int) bool { return data[i] > data[j] }
+	}
+	if stable {
+		sort.SliceStable(data, less)
+	} else {
+		sort.Slice(data, less)
+	}
+}
+
+// isSortedOrdered checks if data is in ascending order, or in descending order if desc is true.
+func isSortedOrdered[T constraints.Ordered](data []T, desc bool) bool {
+	for i := len(data) - 1; i > 0; i-- {
+		if (!desc && data[i] < data[i-1]) || (desc && data[i] > data[i-1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package sslice
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+type employee struct {
+	Name string
+	Dept string
+	Age  int
+}
+
+func employeeNames(s *Slice[employee]) []string {
+	var names []string
+	s.Iterate(func(e employee) bool {
+		names = append(names, e.Name)
+		return true
+	})
+	return names
+}
+
+func TestSlice_SortFunc(t *testing.T) {
+	s := New(3, 1, 2).SortFunc(func(a, b int) bool { return a > b })
+	assert.Equal(t, []int{3, 2, 1}, s.Slice())
+	assert.True(t, s.IsSorted(func(a, b int) bool { return a > b }))
+	assert.False(t, s.IsSorted(func(a, b int) bool { return a < b }))
+	assert.True(t, New[int]().IsSorted(func(a, b int) bool { return a < b }))
+}
+
+func TestSlice_SortStableFunc_KeepsOrderOfEqualElements(t *testing.T) {
+	s := New(
+		employee{"a", "ops", 30},
+		employee{"b", "dev", 40},
+		employee{"c", "ops", 20},
+		employee{"d", "dev", 30},
+	)
+	s.SortStableFunc(func(a, b employee) bool { return a.Dept < b.Dept })
+	assert.Equal(t, []string{"b", "d", "a", "c"}, employeeNames(s))
+}
+
+func TestSlice_SortBy_MultipleKeys(t *testing.T) {
+	s := New(
+		employee{"a", "ops", 30},
+		employee{"b", "dev", 40},
+		employee{"c", "ops", 20},
+		employee{"d", "dev", 30},
+		employee{"e", "ops", 30},
+	)
+	byDept := By(func(e employee) string { return e.Dept })
+	byAge := By(func(e employee) int { return e.Age })
+
+	s.SortBy(byDept, byAge.Desc())
+	assert.Equal(t, []string{"b", "d", "a", "e", "c"}, employeeNames(s))
+
+	// ties on all keys keep their order
+	s.SortBy(byAge)
+	assert.Equal(t, []string{"c", "d", "a", "e", "b"}, employeeNames(s))
+	assert.True(t, s.IsSorted(func(a, b employee) bool { return a.Age < b.Age }))
+
+	// no key keeps the order
+	s.SortBy()
+	assert.Equal(t, []string{"c", "d", "a", "e", "b"}, employeeNames(s))
+}
+
+func TestOrderedSlice_SortStable(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	s := NewOrderedSlice(1, negZero, -1, 0, negZero).SortStable()
+	got := s.Slice()
+	assert.Equal(t, []float64{-1, 0, 0, 0, 1}, got)
+	// +0 and -0 are equal, so they keep their original order
+	assert.True(t, math.Signbit(got[1]))
+	assert.False(t, math.Signbit(got[2]))
+	assert.True(t, math.Signbit(got[3]))
+	assert.True(t, s.IsSorted())
+	assert.False(t, s.IsSorted(true))
+
+	assert.Equal(t, []float64{1, 0, 0, 0, -1}, s.SortStable(true).Slice())
+	assert.True(t, s.IsSorted(true))
+}
+
+func TestComputableSlice_SortStableAndIsSorted(t *testing.T) {
+	s := NewComputableSlice(3, 1, 2)
+	assert.False(t, s.IsSorted())
+	assert.Equal(t, []int{1, 2, 3}, s.SortStable().Slice())
+	assert.True(t, s.IsSorted())
+	assert.Equal(t, []int{3, 2, 1}, s.SortStable(true).Slice())
+}
+
+func TestConcurrentSlice_SortBy(t *testing.T) {
+	s := NewConcurrent(employee{"a", "ops", 30}, employee{"b", "dev", 40})
+	s.SortBy(By(func(e employee) string { return e.Dept }))
+	assert.Equal(t, "b", s.Get(0).Name)
+	assert.True(t, s.IsSorted(func(a, b employee) bool { return a.Dept < b.Dept }))
+	s.SortFunc(func(a, b employee) bool { return a.Age < b.Age })
+	assert.Equal(t, "a", s.Get(0).Name)
+	s.SortStableFunc(func(a, b employee) bool { return a.Name > b.Name })
+	assert.Equal(t, "b", s.Get(0).Name)
+}
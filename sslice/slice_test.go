@@ -288,3 +288,17 @@ func TestPopRight_NonEmptySlice_RemovesLastElement(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expected, result.data)
 	}
 }
+
+// TestIterate_StopsWhenFalseReturned tests Iterate stops the iteration when f returns false.
+func TestIterate_StopsWhenFalseReturned(t *testing.T) {
+	s := New(1, 2, 3, 4)
+	var visited []int
+	s.Iterate(func(x int) bool {
+		visited = append(visited, x)
+		return x < 2
+	})
+	expected := []int{1, 2}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Errorf("Expected %v, got %v", expected, visited)
+	}
+}
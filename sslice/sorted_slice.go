@@ -0,0 +1,193 @@
+package sslice
+
+import (
+	"github.com/chaseSpace/bear/constraints"
+	"sort"
+)
+
+// SortedSlice is a slice that always keeps its elements in ascending order,
+// so that lookups can use binary search.
+type SortedSlice[T constraints.Ordered] struct {
+	slice *Slice[T]
+}
+
+// NewSortedSlice creates a new SortedSlice. The items are copied and sorted in ascending order.
+func NewSortedSlice[T constraints.Ordered](items ...T) *SortedSlice[T] {
+	var data = make([]T, len(items))
+	copy(data, items)
+	sort.Slice(data, func(i, j int) bool {
+		return data[i] < data[j]
+	})
+	return &SortedSlice[T]{slice: New(data...)}
+}
+
+// Insert inserts items into SortedSlice, keeping the ascending order.
+// An item equal to existing elements is inserted after them.
+func (s *SortedSlice[T]) Insert(items ...T) *SortedSlice[T] {
+	for _, item := range items {
+		i := s.UpperBound(item)
+		s.slice.data = append(s.slice.data, item)
+		copy(s.slice.data[i+1:], s.slice.data[i:])
+		s.slice.data[i] = item
+	}
+	return s
+}
+
+// Delete deletes all the occurrences of items from SortedSlice.
+func (s *SortedSlice[T]) Delete(items ...T) *SortedSlice[T] {
+	for _, item := range items {
+		lo, hi := s.LowerBound(item), s.UpperBound(item)
+		if lo < hi {
+			s.slice.data = append(s.slice.data[:lo], s.slice.data[hi:]...)
+		}
+	}
+	return s
+}
+
+// Clone returns a copy of SortedSlice.
+func (s *SortedSlice[T]) Clone() *SortedSlice[T] {
+	return &SortedSlice[T]{slice: s.slice.Clone()}
+}
+
+// Filter filters the elements in SortedSlice. It removes elements that match f function.
+func (s *SortedSlice[T]) Filter(f func(T) bool) *SortedSlice[T] {
+	s.slice.Filter(f)
+	return s
+}
+
+// Unique removes duplicate elements in SortedSlice.
+func (s *SortedSlice[T]) Unique() *SortedSlice[T] {
+	var data = s.slice.data
+	if len(data) < 2 {
+		return s
+	}
+	var n = 1
+	for i := 1; i < len(data); i++ {
+		if data[i] != data[n-1] {
+			data[n] = data[i]
+			n++
+		}
+	}
+	s.slice.data = data[:n]
+	return s
+}
+
+// PopLeft pops the leftmost(minimum) element in SortedSlice.
+func (s *SortedSlice[T]) PopLeft() *SortedSlice[T] {
+	s.slice.PopLeft()
+	return s
+}
+
+// PopRight pops the rightmost(maximum) element in SortedSlice.
+func (s *SortedSlice[T]) PopRight() *SortedSlice[T] {
+	s.slice.PopRight()
+	return s
+}
+
+// Range returns a new SortedSlice which contains the elements in the closed interval [lo, hi].
+func (s *SortedSlice[T]) Range(lo, hi T) *SortedSlice[T] {
+	var data = []T{}
+	if lo <= hi {
+		data = append(data, s.slice.data[s.LowerBound(lo):s.UpperBound(hi)]...)
+	}
+	return &SortedSlice[T]{slice: New(data...)}
+}
+
+// ------------------ split line ------------------------
+// - Below are non-chain methods.
+
+// LowerBound returns the index of the first element that is greater than or equal to item.
+// It returns Len() if there is no such element.
+func (s *SortedSlice[T]) LowerBound(item T) int {
+	return sort.Search(len(s.slice.data), func(i int) bool {
+		return s.slice.data[i] >= item
+	})
+}
+
+// UpperBound returns the index of the first element that is greater than item.
+// It returns Len() if there is no such element.
+func (s *SortedSlice[T]) UpperBound(item T) int {
+	return sort.Search(len(s.slice.data), func(i int) bool {
+		return s.slice.data[i] > item
+	})
+}
+
+// Contains returns true if the element is in SortedSlice.
+func (s *SortedSlice[T]) Contains(item T) bool {
+	return s.IndexOf(item) >= 0
+}
+
+// IndexOf returns the index of the first occurrence of the element in SortedSlice.
+// If the element is not found, it returns -1.
+func (s *SortedSlice[T]) IndexOf(item T) int {
+	i := s.LowerBound(item)
+	if i < len(s.slice.data) && s.slice.data[i] == item {
+		return i
+	}
+	return -1
+}
+
+// Floor returns the greatest element that is less than or equal to item.
+// The bool is false if there is no such element.
+func (s *SortedSlice[T]) Floor(item T) (floor T, ok bool) {
+	i := s.UpperBound(item)
+	if i == 0 {
+		return
+	}
+	return s.slice.data[i-1], true
+}
+
+// Ceil returns the least element that is greater than or equal to item.
+// The bool is false if there is no such element.
+func (s *SortedSlice[T]) Ceil(item T) (ceil T, ok bool) {
+	i := s.LowerBound(item)
+	if i == len(s.slice.data) {
+		return
+	}
+	return s.slice.data[i], true
+}
+
+// Rank returns the number of elements that are less than item.
+func (s *SortedSlice[T]) Rank(item T) int {
+	return s.LowerBound(item)
+}
+
+// Slice returns a copy of the elements in SortedSlice.
+func (s *SortedSlice[T]) Slice() (copied []T) {
+	return s.slice.Slice()
+}
+
+// Len returns the length of SortedSlice.
+func (s *SortedSlice[T]) Len() int {
+	return s.slice.Len()
+}
+
+// Get returns the element at the given index.
+func (s *SortedSlice[T]) Get(index int) T {
+	return s.slice.Get(index)
+}
+
+// Reduce reduces the elements in SortedSlice by the given function.
+func (s *SortedSlice[T]) Reduce(f func(x, y T) T) T {
+	return s.slice.Reduce(f)
+}
+
+// Equal returns true if the elements in SortedSlice are equal to the elements in other.
+func (s *SortedSlice[T]) Equal(other *SortedSlice[T]) bool {
+	return s.slice.Equal(other.slice)
+}
+
+// Join joins the elements in SortedSlice by the given separator.
+func (s *SortedSlice[T]) Join(sep string) string {
+	return s.slice.Join(sep)
+}
+
+// IsEmpty returns true if the underlying slice is empty.
+func (s *SortedSlice[T]) IsEmpty() bool {
+	return s.slice.IsEmpty()
+}
+
+// Iterate calls f sequentially for each element in SortedSlice. If f returns false, Iterate stops the iteration.
+func (s *SortedSlice[T]) Iterate(f func(T) bool) {
+	s.slice.Iterate(f)
+}
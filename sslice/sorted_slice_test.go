@@ -0,0 +1,119 @@
+package sslice
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestNewSortedSlice_UnsortedInput_SortsCopy tests that NewSortedSlice sorts a copy of the input.
+func TestNewSortedSlice_UnsortedInput_SortsCopy(t *testing.T) {
+	input := []int{3, 1, 2}
+	s := NewSortedSlice(input...)
+	if !reflect.DeepEqual(s.slice.data, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", s.slice.data)
+	}
+	if !reflect.DeepEqual(input, []int{3, 1, 2}) {
+		t.Errorf("the input slice has been changed")
+	}
+}
+
+// TestSortedSlice_Insert_KeepsOrder tests that Insert keeps the sorted invariant.
+func TestSortedSlice_Insert_KeepsOrder(t *testing.T) {
+	s := NewSortedSlice[int]()
+	s.Insert(5, 1, 3, 3, 9, 0)
+	expected := []int{0, 1, 3, 3, 5, 9}
+	if !reflect.DeepEqual(s.slice.data, expected) {
+		t.Errorf("Expected %v, got %v", expected, s.slice.data)
+	}
+}
+
+// TestSortedSlice_Delete_RemovesAllOccurrences tests that Delete removes every occurrence.
+func TestSortedSlice_Delete_RemovesAllOccurrences(t *testing.T) {
+	s := NewSortedSlice(1, 2, 2, 2, 3).Delete(2, 4)
+	expected := []int{1, 3}
+	if !reflect.DeepEqual(s.slice.data, expected) {
+		t.Errorf("Expected %v, got %v", expected, s.slice.data)
+	}
+}
+
+// TestSortedSlice_Unique_RemovesDuplicates tests Unique on a sorted slice.
+func TestSortedSlice_Unique_RemovesDuplicates(t *testing.T) {
+	s := NewSortedSlice("b", "a", "b", "c", "a").Unique()
+	expected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(s.slice.data, expected) {
+		t.Errorf("Expected %v, got %v", expected, s.slice.data)
+	}
+}
+
+// TestSortedSlice_Bounds tests LowerBound, UpperBound, Rank, IndexOf and Contains.
+func TestSortedSlice_Bounds(t *testing.T) {
+	s := NewSortedSlice(10, 20, 20, 30)
+	tests := []struct {
+		item                  int
+		lower, upper, indexOf int
+		contains              bool
+	}{
+		{5, 0, 0, -1, false},
+		{10, 0, 1, 0, true},
+		{20, 1, 3, 1, true},
+		{25, 3, 3, -1, false},
+		{30, 3, 4, 3, true},
+		{35, 4, 4, -1, false},
+	}
+	for _, test := range tests {
+		if got := s.LowerBound(test.item); got != test.lower {
+			t.Errorf("LowerBound(%d) = %d, want %d", test.item, got, test.lower)
+		}
+		if got := s.UpperBound(test.item); got != test.upper {
+			t.Errorf("UpperBound(%d) = %d, want %d", test.item, got, test.upper)
+		}
+		if got := s.Rank(test.item); got != test.lower {
+			t.Errorf("Rank(%d) = %d, want %d", test.item, got, test.lower)
+		}
+		if got := s.IndexOf(test.item); got != test.indexOf {
+			t.Errorf("IndexOf(%d) = %d, want %d", test.item, got, test.indexOf)
+		}
+		if got := s.Contains(test.item); got != test.contains {
+			t.Errorf("Contains(%d) = %v, want %v", test.item, got, test.contains)
+		}
+	}
+}
+
+// TestSortedSlice_FloorCeil tests Floor and Ceil.
+func TestSortedSlice_FloorCeil(t *testing.T) {
+	s := NewSortedSlice(1.5, 2.5, 3.5)
+	if v, ok := s.Floor(3.0); !ok || v != 2.5 {
+		t.Errorf("Floor(3.0) = %v, %v, want 2.5, true", v, ok)
+	}
+	if v, ok := s.Floor(2.5); !ok || v != 2.5 {
+		t.Errorf("Floor(2.5) = %v, %v, want 2.5, true", v, ok)
+	}
+	if _, ok := s.Floor(1.0); ok {
+		t.Errorf("Floor(1.0) should not exist")
+	}
+	if v, ok := s.Ceil(3.0); !ok || v != 3.5 {
+		t.Errorf("Ceil(3.0) = %v, %v, want 3.5, true", v, ok)
+	}
+	if _, ok := s.Ceil(4.0); ok {
+		t.Errorf("Ceil(4.0) should not exist")
+	}
+}
+
+// TestSortedSlice_Range_ReturnsClosedInterval tests Range returns the elements in [lo, hi].
+func TestSortedSlice_Range_ReturnsClosedInterval(t *testing.T) {
+	s := NewSortedSlice(1, 3, 5, 7, 9)
+	if got := s.Range(3, 7).Slice(); !reflect.DeepEqual(got, []int{3, 5, 7}) {
+		t.Errorf("Range(3, 7) = %v, want [3 5 7]", got)
+	}
+	if got := s.Range(4, 4).Slice(); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("Range(4, 4) = %v, want []", got)
+	}
+	if got := s.Range(7, 3).Slice(); !reflect.DeepEqual(got, []int{}) {
+		t.Errorf("Range(7, 3) = %v, want []", got)
+	}
+	// the result must not share data with the origin
+	s.Range(1, 9).Insert(0)
+	if s.Len() != 5 {
+		t.Errorf("the origin slice has been changed")
+	}
+}
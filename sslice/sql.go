@@ -0,0 +1,79 @@
+package sslice
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"github.com/chaseSpace/bear/internal/pgarray"
+)
+
+// Value implements driver.Valuer, so that Slice can be passed to sql.DB.Exec and friends.
+// It encodes Slice as a JSON array, which suits JSON columns.
+// A nil Slice is encoded as NULL. Use AsPgArray for Postgres array columns.
+func (s *Slice[T]) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := s.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner, so that Slice can be passed to sql.Rows.Scan and friends.
+// It accepts a JSON array, or a Postgres array literal like {1,2,3}, and replaces the elements in Slice.
+// NULL makes Slice empty.
+func (s *Slice[T]) Scan(src interface{}) error {
+	var text string
+	switch src := src.(type) {
+	case nil:
+		s.data = []T{}
+		return nil
+	case []byte:
+		text = string(src)
+	case string:
+		text = src
+	default:
+		return fmt.Errorf("cannot scan %T into Slice", src)
+	}
+	if len(text) > 0 && text[0] == '{' {
+		items, err := pgarray.Parse[T](text)
+		if err != nil {
+			return err
+		}
+		s.data = New(items...).data
+		return nil
+	}
+	return s.UnmarshalJSON([]byte(text))
+}
+
+// PgArray adapts Slice to a Postgres array column, see AsPgArray.
+type PgArray[T comparable] struct {
+	slice *Slice[T]
+}
+
+// AsPgArray returns an adapter that encodes s as a Postgres array literal like {1,2,3} or {"a","b"}, and
+// decodes such literals into s. A nil s is encoded as NULL.
+// The element type must be a string, integer, float or bool type.
+//
+//	db.Exec("INSERT INTO t (ids) VALUES ($1)", sslice.AsPgArray(ids))
+//	row.Scan(sslice.AsPgArray(ids))
+func AsPgArray[T comparable](s *Slice[T]) PgArray[T] {
+	return PgArray[T]{slice: s}
+}
+
+// Value implements driver.Valuer.
+func (a PgArray[T]) Value() (driver.Value, error) {
+	if a.slice == nil {
+		return nil, nil
+	}
+	return pgarray.Format(a.slice.data)
+}
+
+// Scan implements sql.Scanner. It replaces the elements in the adapted Slice, and NULL makes it empty.
+func (a PgArray[T]) Scan(src interface{}) error {
+	if a.slice == nil {
+		return fmt.Errorf("cannot scan into nil Slice")
+	}
+	return a.slice.Scan(src)
+}
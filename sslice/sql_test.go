@@ -0,0 +1,81 @@
+package sslice
+
+import (
+	"database/sql"
+	"github.com/chaseSpace/bear/internal/fakesql"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSlice_SQL_JSON(t *testing.T) {
+	db, err := fakesql.Open()
+	assert.Nil(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("SET", "ids", New(3, 1, 2))
+	assert.Nil(t, err)
+
+	var ids = New[int]()
+	assert.Nil(t, db.QueryRow("GET", "ids").Scan(ids))
+	assert.Equal(t, []int{3, 1, 2}, ids.Slice())
+}
+
+func TestSlice_SQL_PgArray(t *testing.T) {
+	db, err := fakesql.Open()
+	assert.Nil(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("SET", "tags", AsPgArray(New("a", `b"c`, "d,e")))
+	assert.Nil(t, err)
+	var raw string
+	assert.Nil(t, db.QueryRow("GET", "tags").Scan(&raw))
+	assert.Equal(t, `{"a","b\"c","d,e"}`, raw)
+
+	var tags = New[string]()
+	assert.Nil(t, db.QueryRow("GET", "tags").Scan(AsPgArray(tags)))
+	assert.Equal(t, []string{"a", `b"c`, "d,e"}, tags.Slice())
+}
+
+func TestSlice_SQL_Null(t *testing.T) {
+	db, err := fakesql.Open()
+	assert.Nil(t, err)
+	defer db.Close()
+
+	var nilSlice *Slice[int]
+	_, err = db.Exec("SET", "a", nilSlice)
+	assert.Nil(t, err)
+	_, err = db.Exec("SET", "b", AsPgArray(nilSlice))
+	assert.Nil(t, err)
+
+	for _, key := range []string{"a", "b"} {
+		var raw sql.NullString
+		assert.Nil(t, db.QueryRow("GET", key).Scan(&raw))
+		assert.False(t, raw.Valid)
+
+		var ids = New(1)
+		assert.Nil(t, db.QueryRow("GET", key).Scan(ids))
+		assert.True(t, ids.IsEmpty())
+	}
+}
+
+func TestSlice_Scan(t *testing.T) {
+	var s = New[int]()
+	assert.Nil(t, s.Scan(`{1, 2}`))
+	assert.Equal(t, []int{1, 2}, s.Slice())
+	assert.Nil(t, s.Scan([]byte(`[3]`)))
+	assert.Equal(t, []int{3}, s.Slice())
+
+	assert.NotNil(t, s.Scan(1))
+	assert.NotNil(t, s.Scan(`{a}`))
+	assert.NotNil(t, s.Scan(`[`))
+	assert.NotNil(t, AsPgArray[int](nil).Scan(`{1}`))
+}
+
+func TestPgArray_Value(t *testing.T) {
+	v, err := AsPgArray(New(1.5, 2)).Value()
+	assert.Nil(t, err)
+	assert.Equal(t, `{1.5,2}`, v)
+
+	_, err = AsPgArray(New(struct{}{})).Value()
+	assert.NotNil(t, err)
+}
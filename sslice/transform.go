@@ -0,0 +1,96 @@
+package sslice
+
+import (
+	"github.com/chaseSpace/bear/constraints"
+	"github.com/chaseSpace/bear/sset"
+)
+
+// The functions below transform a Slice into containers of another element type.
+// Go does not allow type parameters on methods, so they are package-level functions.
+// To use them with OrderedSlice or ComputableSlice, pass the result of AsSlice().
+
+// MapTo maps each element of s to a new type by f. It returns a new Slice.
+func MapTo[T, U comparable](s *Slice[T], f func(T) U) *Slice[U] {
+	var data = make([]U, 0, len(s.data))
+	for _, item := range s.data {
+		data = append(data, f(item))
+	}
+	return &Slice[U]{data: data}
+}
+
+// MapToSet maps each element of s to a new type by f, and collects the results into a new Set.
+func MapToSet[T, U comparable](s *Slice[T], f func(T) U) *sset.Set[U] {
+	var set = sset.New[U]()
+	for _, item := range s.data {
+		set.Add(f(item))
+	}
+	return set
+}
+
+// FlatMap maps each element of s to a slice of a new type by f, and flattens the results into a new Slice.
+func FlatMap[T, U comparable](s *Slice[T], f func(T) []U) *Slice[U] {
+	var data = make([]U, 0, len(s.data))
+	for _, item := range s.data {
+		data = append(data, f(item)...)
+	}
+	return &Slice[U]{data: data}
+}
+
+// GroupBy groups the elements of s by the key returned by f.
+// The order of elements in each group is the same as in s.
+func GroupBy[T, K comparable](s *Slice[T], f func(T) K) map[K]*Slice[T] {
+	var groups = make(map[K]*Slice[T])
+	for _, item := range s.data {
+		k := f(item)
+		if g, ok := groups[k]; ok {
+			g.data = append(g.data, item)
+		} else {
+			groups[k] = &Slice[T]{data: []T{item}}
+		}
+	}
+	return groups
+}
+
+// KeyBy indexes the elements of s by the key returned by f.
+// If several elements have the same key, the last one wins.
+func KeyBy[T, K comparable](s *Slice[T], f func(T) K) map[K]T {
+	var m = make(map[K]T, len(s.data))
+	for _, item := range s.data {
+		m[f(item)] = item
+	}
+	return m
+}
+
+// Partition splits s into two new Slices: the elements that match f, and the rest.
+func Partition[T comparable](s *Slice[T], f func(T) bool) (matched, rest *Slice[T]) {
+	matched, rest = New[T](), New[T]()
+	for _, item := range s.data {
+		if f(item) {
+			matched.data = append(matched.data, item)
+		} else {
+			rest.data = append(rest.data, item)
+		}
+	}
+	return
+}
+
+// ToMultiSet counts the elements of s into a new MultiSet.
+func ToMultiSet[T comparable](s *Slice[T]) *sset.MultiSet[T] {
+	return sset.NewMulti(s.data...)
+}
+
+// FromMultiSet returns a new Slice of the items in m, each repeated by its count.
+// The order of the distinct items is unspecified.
+func FromMultiSet[T comparable](m *sset.MultiSet[T]) *Slice[T] {
+	return New(m.Slice()...)
+}
+
+// AsOrdered wraps s as an OrderedSlice. The two share the same underlying data.
+func AsOrdered[T constraints.Ordered](s *Slice[T]) *OrderedSlice[T] {
+	return &OrderedSlice[T]{slice: s}
+}
+
+// AsComputable wraps s as a ComputableSlice. The two share the same underlying data.
+func AsComputable[T constraints.Computable](s *Slice[T]) *ComputableSlice[T] {
+	return &ComputableSlice[T]{slice: s}
+}
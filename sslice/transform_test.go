@@ -0,0 +1,115 @@
+package sslice
+
+import (
+	"github.com/chaseSpace/bear/sset"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// TestMapTo_IntToString_ReturnsMappedSlice tests mapping a slice of ints to a slice of strings.
+func TestMapTo_IntToString_ReturnsMappedSlice(t *testing.T) {
+	s := New(1, 2, 3)
+	result := MapTo(s, strconv.Itoa)
+	expected := []string{"1", "2", "3"}
+	if !reflect.DeepEqual(result.data, expected) {
+		t.Errorf("Expected %v, got %v", expected, result.data)
+	}
+}
+
+// TestMapTo_EmptySlice_ReturnsEmptySlice tests mapping an empty slice.
+func TestMapTo_EmptySlice_ReturnsEmptySlice(t *testing.T) {
+	result := MapTo(New[int](), strconv.Itoa)
+	if !reflect.DeepEqual(result.data, []string{}) {
+		t.Errorf("Expected empty slice, got %v", result.data)
+	}
+}
+
+// TestMapTo_OrderedSlice_WorksThroughAsSlice tests MapTo on an OrderedSlice and wrapping the result again.
+func TestMapTo_OrderedSlice_WorksThroughAsSlice(t *testing.T) {
+	s := NewOrderedSlice(3, 1, 2)
+	result := AsOrdered(MapTo(s.AsSlice(), func(i int) string { return strconv.Itoa(i * 10) })).Sort()
+	expected := []string{"10", "20", "30"}
+	if !reflect.DeepEqual(result.Slice(), expected) {
+		t.Errorf("Expected %v, got %v", expected, result.Slice())
+	}
+}
+
+// TestMapToSet_DuplicateResults_ReturnsUniqueSet tests MapToSet removes duplicate results.
+func TestMapToSet_DuplicateResults_ReturnsUniqueSet(t *testing.T) {
+	s := New(1, 2, 3, 4)
+	result := MapToSet(s, func(i int) bool { return i%2 == 0 })
+	if !result.Equal(sset.New(true, false)) {
+		t.Errorf("Expected {true, false}, got %v", result.Slice())
+	}
+}
+
+// TestFlatMap_MultipleElements_ReturnsFlattenedSlice tests FlatMap flattens the results.
+func TestFlatMap_MultipleElements_ReturnsFlattenedSlice(t *testing.T) {
+	s := New("a,b", "c", "")
+	result := FlatMap(s, func(x string) []byte { return []byte(x) })
+	expected := []byte("a,bc")
+	if !reflect.DeepEqual(result.data, expected) {
+		t.Errorf("Expected %v, got %v", expected, result.data)
+	}
+}
+
+// TestGroupBy_MultipleElements_KeepsOrderInGroups tests GroupBy keeps the original order in each group.
+func TestGroupBy_MultipleElements_KeepsOrderInGroups(t *testing.T) {
+	s := NewComputableSlice(1, 2, 3, 4, 5, 6)
+	groups := GroupBy(s.AsSlice(), func(i int) string {
+		if i%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+	if !reflect.DeepEqual(groups["even"].data, []int{2, 4, 6}) {
+		t.Errorf("Expected [2 4 6], got %v", groups["even"].data)
+	}
+	if AsComputable(groups["odd"]).Sum() != 9 {
+		t.Errorf("Expected sum of odd group to be 9, got %v", groups["odd"].data)
+	}
+}
+
+// TestKeyBy_DuplicateKeys_LastOneWins tests KeyBy keeps the last element for a duplicate key.
+func TestKeyBy_DuplicateKeys_LastOneWins(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	s := New(user{1, "a"}, user{2, "b"}, user{1, "c"})
+	result := KeyBy(s, func(u user) int { return u.ID })
+	expected := map[int]user{1: {1, "c"}, 2: {2, "b"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+// TestPartition_MixedPredicate_SplitsSlice tests Partition splits the slice by the predicate.
+func TestPartition_MixedPredicate_SplitsSlice(t *testing.T) {
+	s := New(1, 2, 3, 4, 5)
+	matched, rest := Partition(s, func(i int) bool { return i > 3 })
+	if !reflect.DeepEqual(matched.data, []int{4, 5}) {
+		t.Errorf("Expected [4 5], got %v", matched.data)
+	}
+	if !reflect.DeepEqual(rest.data, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3], got %v", rest.data)
+	}
+	if !reflect.DeepEqual(s.data, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("the origin slice has been changed")
+	}
+}
+
+func TestToMultiSetAndBack(t *testing.T) {
+	m := ToMultiSet(New("a", "b", "a"))
+	if m.Count("a") != 2 || m.Count("b") != 1 {
+		t.Errorf("Expected counts a=2 b=1, got a=%d b=%d", m.Count("a"), m.Count("b"))
+	}
+	s := AsOrdered(FromMultiSet(m)).Sort()
+	if !reflect.DeepEqual(s.Slice(), []string{"a", "a", "b"}) {
+		t.Errorf("Expected [a a b], got %v", s.Slice())
+	}
+}
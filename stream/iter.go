@@ -0,0 +1,10 @@
+//go:build go1.23
+
+package stream
+
+import "iter"
+
+// Values returns an iterator over the elements of the Stream. The Stream runs while the iterator is being ranged over.
+func (s *Stream[T]) Values() iter.Seq[T] {
+	return s.seq
+}
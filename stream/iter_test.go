@@ -0,0 +1,20 @@
+//go:build go1.23
+
+package stream
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// TestStream_Values_RangeOverFunc tests ranging over a Stream with break.
+func TestStream_Values_RangeOverFunc(t *testing.T) {
+	var got []int
+	for v := range Of(1, 2, 3, 4).Map(func(i int) int { return i * 2 }).Values() {
+		if v > 6 {
+			break
+		}
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{2, 4, 6}, got)
+}
@@ -0,0 +1,267 @@
+package stream
+
+import (
+	"github.com/chaseSpace/bear/sset"
+	"github.com/chaseSpace/bear/sslice"
+)
+
+// Stream is a lazy sequence of elements. Intermediate operations (Filter, Map, Take, etc.) only
+// record what to do; nothing runs until a terminal operation (Collect, ToSet, Reduce, etc.) is called.
+// All operations of a Stream are fused into a single pass over the source, and short-circuit
+// operations stop reading the source as soon as the result is known.
+//
+// A Stream reads its source again on each terminal operation, so the source should not be
+// modified while the terminal operation is running.
+type Stream[T comparable] struct {
+	seq func(yield func(T) bool)
+}
+
+// From creates a Stream from a push-style sequence. The sequence calls yield for each element,
+// and stops when yield returns false.
+// Every bear container has an Iterate method that can be passed here, e.g. stream.From(set.Iterate).
+func From[T comparable](seq func(yield func(T) bool)) *Stream[T] {
+	return &Stream[T]{seq: seq}
+}
+
+// Of creates a Stream from the given items.
+func Of[T comparable](items ...T) *Stream[T] {
+	return From(func(yield func(T) bool) {
+		for _, item := range items {
+			if !yield(item) {
+				return
+			}
+		}
+	})
+}
+
+// ---------------------- Intermediate Operations ----------------------
+
+// Filter keeps the elements that match f.
+// Note that this is the opposite of Slice.Filter, which removes the elements that match f, so a Slice chain
+// s.Filter(f) is ported as stream.From(s.Iterate).Filter(func(x T) bool { return !f(x) }).
+func (s *Stream[T]) Filter(f func(T) bool) *Stream[T] {
+	seq := s.seq
+	return From(func(yield func(T) bool) {
+		seq(func(item T) bool {
+			return !f(item) || yield(item)
+		})
+	})
+}
+
+// Map maps each element by f. Use the package-level Map to change the element type.
+func (s *Stream[T]) Map(f func(T) T) *Stream[T] {
+	return Map(s, f)
+}
+
+// Unique removes duplicate elements, keeping the first occurrence.
+func (s *Stream[T]) Unique() *Stream[T] {
+	seq := s.seq
+	return From(func(yield func(T) bool) {
+		var seen = make(map[T]struct{})
+		seq(func(item T) bool {
+			if _, ok := seen[item]; ok {
+				return true
+			}
+			seen[item] = struct{}{}
+			return yield(item)
+		})
+	})
+}
+
+// Peek calls f for each element as it flows through the Stream.
+func (s *Stream[T]) Peek(f func(T)) *Stream[T] {
+	seq := s.seq
+	return From(func(yield func(T) bool) {
+		seq(func(item T) bool {
+			f(item)
+			return yield(item)
+		})
+	})
+}
+
+// Take keeps at most the first n elements.
+func (s *Stream[T]) Take(n int) *Stream[T] {
+	seq := s.seq
+	return From(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		var taken int
+		seq(func(item T) bool {
+			taken++
+			return yield(item) && taken < n
+		})
+	})
+}
+
+// Skip drops the first n elements.
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	seq := s.seq
+	return From(func(yield func(T) bool) {
+		var skipped int
+		seq(func(item T) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+			return yield(item)
+		})
+	})
+}
+
+// TakeWhile keeps elements as long as they match f, and stops at the first one that does not.
+func (s *Stream[T]) TakeWhile(f func(T) bool) *Stream[T] {
+	seq := s.seq
+	return From(func(yield func(T) bool) {
+		seq(func(item T) bool {
+			return f(item) && yield(item)
+		})
+	})
+}
+
+// DropWhile drops elements as long as they match f, and keeps all elements from the first one that does not.
+func (s *Stream[T]) DropWhile(f func(T) bool) *Stream[T] {
+	seq := s.seq
+	return From(func(yield func(T) bool) {
+		var dropping = true
+		seq(func(item T) bool {
+			if dropping && f(item) {
+				return true
+			}
+			dropping = false
+			return yield(item)
+		})
+	})
+}
+
+// Map maps each element of s to a new type by f.
+func Map[T, U comparable](s *Stream[T], f func(T) U) *Stream[U] {
+	seq := s.seq
+	return From(func(yield func(U) bool) {
+		seq(func(item T) bool {
+			return yield(f(item))
+		})
+	})
+}
+
+// FlatMap maps each element of s to a slice of a new type by f, and flattens the results.
+func FlatMap[T, U comparable](s *Stream[T], f func(T) []U) *Stream[U] {
+	seq := s.seq
+	return From(func(yield func(U) bool) {
+		seq(func(item T) bool {
+			for _, u := range f(item) {
+				if !yield(u) {
+					return false
+				}
+			}
+			return true
+		})
+	})
+}
+
+// ---------------------- Terminal Operations ----------------------
+
+// Iterate runs the Stream and calls f for each element. If f returns false, Iterate stops the iteration.
+func (s *Stream[T]) Iterate(f func(T) bool) {
+	s.seq(f)
+}
+
+// ForEach runs the Stream and calls f for each element.
+func (s *Stream[T]) ForEach(f func(T)) {
+	s.seq(func(item T) bool {
+		f(item)
+		return true
+	})
+}
+
+// ToSlice runs the Stream and returns the elements as a standard Go slice.
+func (s *Stream[T]) ToSlice() []T {
+	var items = []T{}
+	s.seq(func(item T) bool {
+		items = append(items, item)
+		return true
+	})
+	return items
+}
+
+// Collect runs the Stream and returns the elements as a new Slice.
+func (s *Stream[T]) Collect() *sslice.Slice[T] {
+	return sslice.New(s.ToSlice()...)
+}
+
+// ToSet runs the Stream and returns the elements as a new Set.
+func (s *Stream[T]) ToSet() *sset.Set[T] {
+	var set = sset.New[T]()
+	s.seq(func(item T) bool {
+		set.Add(item)
+		return true
+	})
+	return set
+}
+
+// Reduce runs the Stream and reduces the elements to a single value.
+// It returns the zero value if the Stream is empty.
+func (s *Stream[T]) Reduce(f func(x, y T) T) T {
+	var result T
+	var first = true
+	s.seq(func(item T) bool {
+		if first {
+			result, first = item, false
+		} else {
+			result = f(result, item)
+		}
+		return true
+	})
+	return result
+}
+
+// Count runs the Stream and returns the number of elements.
+func (s *Stream[T]) Count() int {
+	var count int
+	s.seq(func(T) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// First runs the Stream until the first element, and returns it.
+// The bool is false if the Stream is empty.
+func (s *Stream[T]) First() (first T, ok bool) {
+	s.seq(func(item T) bool {
+		first, ok = item, true
+		return false
+	})
+	return
+}
+
+// Any returns true if any element matches f. It stops at the first match.
+func (s *Stream[T]) Any(f func(T) bool) bool {
+	var found bool
+	s.seq(func(item T) bool {
+		found = f(item)
+		return !found
+	})
+	return found
+}
+
+// All returns true if all elements match f. It stops at the first mismatch.
+// It returns true for an empty Stream.
+func (s *Stream[T]) All(f func(T) bool) bool {
+	var all = true
+	s.seq(func(item T) bool {
+		all = f(item)
+		return all
+	})
+	return all
+}
+
+// Fold runs s and accumulates the elements into a value of another type, starting from init.
+func Fold[T comparable, A any](s *Stream[T], init A, f func(acc A, item T) A) A {
+	var acc = init
+	s.seq(func(item T) bool {
+		acc = f(acc, item)
+		return true
+	})
+	return acc
+}
@@ -0,0 +1,116 @@
+package stream
+
+import (
+	"github.com/chaseSpace/bear/slinkedlist"
+	"github.com/chaseSpace/bear/sset"
+	"github.com/chaseSpace/bear/sslice"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+// TestStream_IsLazy tests that no operation runs before a terminal operation is called.
+func TestStream_IsLazy(t *testing.T) {
+	var calls int
+	s := Of(1, 2, 3).Map(func(i int) int { calls++; return i * 2 })
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, []int{2, 4, 6}, s.ToSlice())
+	assert.Equal(t, 3, calls)
+}
+
+// TestStream_FusedPipeline tests that every operation runs in a single pass, element by element.
+func TestStream_FusedPipeline(t *testing.T) {
+	var trace []string
+	result := Of(1, 2, 3, 2).
+		Peek(func(i int) { trace = append(trace, "peek"+strconv.Itoa(i)) }).
+		Filter(func(i int) bool { return i > 1 }).
+		Map(func(i int) int { trace = append(trace, "map"+strconv.Itoa(i)); return i * 10 }).
+		Unique().
+		ToSlice()
+	assert.Equal(t, []int{20, 30}, result)
+	assert.Equal(t, []string{"peek1", "peek2", "map2", "peek3", "map3", "peek2", "map2"}, trace)
+}
+
+// TestStream_Take_ShortCircuits tests that Take stops reading the source.
+func TestStream_Take_ShortCircuits(t *testing.T) {
+	var read int
+	s := Of(1, 2, 3, 4, 5).Peek(func(int) { read++ })
+	assert.Equal(t, []int{1, 2}, s.Take(2).ToSlice())
+	assert.Equal(t, 2, read)
+	assert.Equal(t, []int{}, s.Take(0).ToSlice())
+}
+
+// TestStream_Skip_DropsFirstElements tests Skip.
+func TestStream_Skip_DropsFirstElements(t *testing.T) {
+	assert.Equal(t, []int{3, 4}, Of(1, 2, 3, 4).Skip(2).ToSlice())
+	assert.Equal(t, []int{}, Of(1, 2).Skip(3).ToSlice())
+}
+
+// TestStream_TakeWhile_DropWhile tests TakeWhile and DropWhile.
+func TestStream_TakeWhile_DropWhile(t *testing.T) {
+	less3 := func(i int) bool { return i < 3 }
+	assert.Equal(t, []int{1, 2}, Of(1, 2, 3, 1).TakeWhile(less3).ToSlice())
+	assert.Equal(t, []int{3, 1}, Of(1, 2, 3, 1).DropWhile(less3).ToSlice())
+}
+
+// TestStream_IsReusable tests that a Stream can run more than once.
+func TestStream_IsReusable(t *testing.T) {
+	s := Of(1, 2, 3, 4).Take(3)
+	assert.Equal(t, 3, s.Count())
+	assert.Equal(t, 3, s.Count())
+}
+
+// TestStream_Terminals tests the terminal operations.
+func TestStream_Terminals(t *testing.T) {
+	s := Of(1, 2, 3, 4)
+	assert.Equal(t, 10, s.Reduce(func(x, y int) int { return x + y }))
+	assert.Equal(t, 0, Of[int]().Reduce(func(x, y int) int { return x + y }))
+	assert.Equal(t, "1234", Fold(s, "", func(acc string, i int) string { return acc + strconv.Itoa(i) }))
+
+	first, ok := s.Filter(func(i int) bool { return i > 2 }).First()
+	assert.True(t, ok)
+	assert.Equal(t, 3, first)
+	_, ok = Of[int]().First()
+	assert.False(t, ok)
+
+	assert.True(t, s.Any(func(i int) bool { return i == 2 }))
+	assert.False(t, s.Any(func(i int) bool { return i == 5 }))
+	assert.True(t, s.All(func(i int) bool { return i > 0 }))
+	assert.False(t, s.All(func(i int) bool { return i < 3 }))
+	assert.True(t, Of[int]().All(func(i int) bool { return false }))
+
+	assert.True(t, s.Collect().Equal(sslice.New(1, 2, 3, 4)))
+	assert.True(t, Of(1, 1, 2).ToSet().Equal(sset.New(1, 2)))
+}
+
+// TestStream_Any_ShortCircuits tests that Any stops at the first match.
+func TestStream_Any_ShortCircuits(t *testing.T) {
+	var read int
+	Of(1, 2, 3, 4).Peek(func(int) { read++ }).Any(func(i int) bool { return i == 2 })
+	assert.Equal(t, 2, read)
+}
+
+// TestMap_FlatMap_ChangeElementType tests the package-level Map and FlatMap.
+func TestMap_FlatMap_ChangeElementType(t *testing.T) {
+	strs := Map(Of(1, 2, 3), strconv.Itoa).ToSlice()
+	assert.Equal(t, []string{"1", "2", "3"}, strs)
+
+	chars := FlatMap(Of("ab", "c"), func(s string) []rune { return []rune(s) }).Take(2).ToSlice()
+	assert.Equal(t, []rune{'a', 'b'}, chars)
+}
+
+// TestFrom_Containers tests creating Streams from every bear container.
+func TestFrom_Containers(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, From(sslice.New(1, 2).Iterate).ToSlice())
+	assert.Equal(t, []int{2, 1}, From(sslice.NewOrderedSlice(2, 1).Iterate).ToSlice())
+	assert.Equal(t, 3.0, From(sslice.NewComputableSlice(1.0, 2.0).Iterate).Reduce(func(x, y float64) float64 { return x + y }))
+	assert.Equal(t, 2, From(sset.New(1, 2, 2).Iterate).Count())
+
+	singly := slinkedlist.NewSinglyLinkedList[int]()
+	singly.Append(1, 2, 3)
+	assert.Equal(t, []int{1, 2}, From(singly.Iterate).Take(2).ToSlice())
+
+	doubly := slinkedlist.NewDoublyLinkedList[int]()
+	doubly.Append(1, 2, 3)
+	assert.Equal(t, []int{2, 3}, From(doubly.Iterate).Skip(1).ToSlice())
+}